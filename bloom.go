@@ -0,0 +1,105 @@
+package formvalidator
+
+import (
+	"hash/maphash"
+	"math"
+)
+
+/*
+	A Bloom filter, a set that uses a few bits per entry instead of the entries themselves
+	Contains() can answer true for an entry that was never added (a false positive), never false for one that was.
+	For very large lists, like breached passwords, see DatasetBloom
+*/
+type bloomFilter struct {
+	bits     []uint64
+	m        uint64 // number of bits
+	k        uint64 // hashes per entry
+	length   int
+	capacity int // entries it was sized for
+}
+
+/*
+	Sized for N entries with the false positive rate P (0.001 for 1 in 1000)
+	Adding more than N entries raises the rate.
+*/
+func newBloomFilter(n int, p float64) *bloomFilter {
+	if n < 1 {
+		n = 1
+	}
+
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := uint64(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+
+	return &bloomFilter{bits: make([]uint64, (m+63)/64), m: m, k: k, capacity: n}
+}
+
+// two hashes from one 64 bit hash, the others are h1 + i*h2 (Kirsch and Mitzenmacher)
+var bloomSeed = maphash.MakeSeed()
+
+func bloomHashes(s string) (uint64, uint64) {
+	sum := maphash.String(bloomSeed, s)
+	return sum & 0xffffffff, sum>>32 | 1
+}
+
+func (b *bloomFilter) add(s string) {
+	h1, h2 := bloomHashes(s)
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) % b.m
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+	b.length++
+}
+
+func (b *bloomFilter) contains(s string) bool {
+	h1, h2 := bloomHashes(s)
+	for i := uint64(0); i < b.k; i++ {
+		bit := (h1 + i*h2) % b.m
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+	Bloom filters that grow, a filter cannot be resized without its entries, so a new one is added when the last is full
+	Each new filter is twice as large with half the false positive rate, the total rate stays below 2*P (a scalable Bloom filter)
+*/
+type bloomFilters struct {
+	filters []*bloomFilter
+	p       float64 // rate of the last filter
+	length  int
+}
+
+// the first filter is sized for at least this many entries, the formula for the size is not accurate for tiny filters
+const bloomMinCapacity = 1024
+
+func newBloomFilters(n int, p float64) *bloomFilters {
+	if n < bloomMinCapacity {
+		n = bloomMinCapacity
+	}
+	return &bloomFilters{filters: []*bloomFilter{newBloomFilter(n, p)}, p: p}
+}
+
+func (b *bloomFilters) add(s string) {
+	last := b.filters[len(b.filters)-1]
+	if last.length >= last.capacity {
+		b.p /= 2
+		last = newBloomFilter(2*last.capacity, b.p)
+		b.filters = append(b.filters, last)
+	}
+	last.add(s)
+	b.length++
+}
+
+func (b *bloomFilters) contains(s string) bool {
+	for _, f := range b.filters {
+		if f.contains(s) {
+			return true
+		}
+	}
+	return false
+}
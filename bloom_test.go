@@ -0,0 +1,60 @@
+package formvalidator
+
+import (
+	"strconv"
+	"testing"
+)
+
+func Test_bloomFilter(t *testing.T) {
+	b := newBloomFilter(10000, 0.001)
+	for n := 0; n < 10000; n++ {
+		b.add("entry" + strconv.Itoa(n))
+	}
+
+	for n := 0; n < 10000; n++ {
+		if !b.contains("entry" + strconv.Itoa(n)) {
+			t.Fatalf("bloomFilter.contains(entry%d): false. Expected: true, no false negatives", n)
+		}
+	}
+
+	falsePositives := 0
+	for n := 0; n < 100000; n++ {
+		if b.contains("other" + strconv.Itoa(n)) {
+			falsePositives++
+		}
+	}
+
+	if falsePositives > 300 { // 0.1% expected, 0.3% allowed
+		t.Errorf("bloomFilter: %d false positives in 100000. Expected: about 100", falsePositives)
+	}
+
+	if b.length != 10000 || len(b.bits)*8 > 20000 {
+		t.Errorf("bloomFilter: length %d, %d bytes. Expected: 10000, about 18000 bytes", b.length, len(b.bits)*8)
+	}
+
+	if empty := newBloomFilter(0, 0.001); empty.contains("a") {
+		t.Errorf("bloomFilter(empty).contains(a): true")
+	}
+}
+
+func Test_bloomFilters(t *testing.T) {
+	b := newBloomFilters(10, 0.001)
+	for n := 0; n < 100000; n++ {
+		b.add("entry" + strconv.Itoa(n))
+	}
+
+	if !b.contains("entry0") || !b.contains("entry99999") || b.length != 100000 {
+		t.Errorf("bloomFilters: entries are missing, length %d", b.length)
+	}
+
+	falsePositives := 0
+	for n := 0; n < 100000; n++ {
+		if b.contains("other" + strconv.Itoa(n)) {
+			falsePositives++
+		}
+	}
+
+	if falsePositives > 300 { // below 0.2% expected
+		t.Errorf("bloomFilters: %d false positives in 100000 after growing to %d filters", falsePositives, len(b.filters))
+	}
+}
@@ -0,0 +1,321 @@
+/*
+	Package checkdigit implements the check digit algorithms used by the validation rules, for account, invoice and other in-house numbers.
+
+	Numbers are strings of digits, Mod97_10 also accepts the letters A-Z (A = 10, B = 11, ..., as in an IBAN).
+	Remove spaces and other separators before calling Verify or Generate.
+*/
+package checkdigit
+
+import (
+	"errors"
+	"strconv"
+)
+
+/*
+	A check digit scheme
+	Verify - the check digit(s) at the end of the number are correct
+	Generate - the check digit(s) to append to the number, "X" stands for 10 in the mod 11 schemes
+*/
+type Algorithm interface {
+	Verify(number string) bool
+	Generate(number string) (string, error)
+}
+
+// errors
+var ErrInvalidCharacter = errors.New("Number contains a character the algorithm does not accept!")
+
+var (
+	Luhn     Algorithm = luhn{}     // credit cards, IMEI, Italian and Swedish tax numbers
+	Verhoeff Algorithm = verhoeff{} // detects all single digit errors and adjacent transpositions
+	Damm     Algorithm = damm{}     // same error detection as Verhoeff, simpler table
+	GS1      Algorithm = gs1{}      // GTIN, EAN, UPC, ISBN-13, weights 3 and 1 from the right
+	Mod11    Algorithm = mod11{}    // ISBN-10, ISSN, weights 2, 3, 4, ... from the right, check digit 0-9 or X
+	Mod11_2  Algorithm = mod11_2{}  // ISO 7064 MOD 11-2, ISNI, ORCID, check digit 0-9 or X
+	Mod11_10 Algorithm = mod11_10{} // ISO 7064 MOD 11,10, German VAT numbers
+	Mod97_10 Algorithm = mod97_10{} // ISO 7064 MOD 97-10, IBAN, two check digits
+)
+
+// digits [0-9] only, not empty
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+// Verify for the algorithms with a single check character: generate it for the rest of the number and compare
+func verifyLast(a Algorithm, number string) bool {
+	if len(number) < 2 {
+		return false
+	}
+
+	check, err := a.Generate(number[:len(number)-1])
+	return err == nil && check == number[len(number)-1:]
+}
+
+// -----------------------
+
+type luhn struct{}
+
+func (l luhn) Verify(number string) bool {
+	return verifyLast(l, number)
+}
+
+func (luhn) Generate(number string) (string, error) {
+	if !isDigits(number) {
+		return "", ErrInvalidCharacter
+	}
+
+	// double every second digit from the right, starting with the rightmost digit of the number (the check digit is added after it)
+	sum := 0
+	for n := len(number) - 1; n >= 0; n-- {
+		d := int(number[n] - '0')
+		if (len(number)-n)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+
+	return strconv.Itoa((10 - sum%10) % 10), nil
+}
+
+// -----------------------
+
+type verhoeff struct{}
+
+var verhoeffD = [10][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+var verhoeffP = [8][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 2, 1, 4},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+var verhoeffInv = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+
+func (verhoeff) Verify(number string) bool {
+	if len(number) < 2 || !isDigits(number) {
+		return false
+	}
+
+	c := 0
+	for n := len(number) - 1; n >= 0; n-- {
+		c = verhoeffD[c][verhoeffP[(len(number)-1-n)%8][number[n]-'0']]
+	}
+	return c == 0
+}
+
+func (verhoeff) Generate(number string) (string, error) {
+	if !isDigits(number) {
+		return "", ErrInvalidCharacter
+	}
+
+	// positions count from the check digit, which is position 0
+	c := 0
+	for n := len(number) - 1; n >= 0; n-- {
+		c = verhoeffD[c][verhoeffP[(len(number)-n)%8][number[n]-'0']]
+	}
+	return strconv.Itoa(verhoeffInv[c]), nil
+}
+
+// -----------------------
+
+type damm struct{}
+
+// a totally anti-symmetric quasigroup of order 10
+var dammTable = [10][10]int{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+func dammInterim(number string) int {
+	interim := 0
+	for n := 0; n < len(number); n++ {
+		interim = dammTable[interim][number[n]-'0']
+	}
+	return interim
+}
+
+func (damm) Verify(number string) bool {
+	return len(number) >= 2 && isDigits(number) && dammInterim(number) == 0
+}
+
+func (damm) Generate(number string) (string, error) {
+	if !isDigits(number) {
+		return "", ErrInvalidCharacter
+	}
+
+	return strconv.Itoa(dammInterim(number)), nil
+}
+
+// -----------------------
+
+type gs1 struct{}
+
+func (g gs1) Verify(number string) bool {
+	return verifyLast(g, number)
+}
+
+func (gs1) Generate(number string) (string, error) {
+	if !isDigits(number) {
+		return "", ErrInvalidCharacter
+	}
+
+	// weight 3 for the rightmost digit of the number, then 1, 3, 1, ...
+	sum := 0
+	for n := len(number) - 1; n >= 0; n-- {
+		w := 1
+		if (len(number)-n)%2 == 1 {
+			w = 3
+		}
+		sum += w * int(number[n]-'0')
+	}
+
+	return strconv.Itoa((10 - sum%10) % 10), nil
+}
+
+// -----------------------
+
+type mod11 struct{}
+
+func (m mod11) Verify(number string) bool {
+	return verifyLast(m, number)
+}
+
+func (mod11) Generate(number string) (string, error) {
+	if !isDigits(number) {
+		return "", ErrInvalidCharacter
+	}
+
+	// weight 2 for the rightmost digit of the number, then 3, 4, ...
+	sum := 0
+	for n := len(number) - 1; n >= 0; n-- {
+		sum += (len(number) - n + 1) * int(number[n]-'0')
+	}
+
+	return mod11Character((11 - sum%11) % 11), nil
+}
+
+func mod11Character(check int) string {
+	if check == 10 {
+		return "X"
+	}
+	return strconv.Itoa(check)
+}
+
+// -----------------------
+
+type mod11_2 struct{}
+
+func (m mod11_2) Verify(number string) bool {
+	return verifyLast(m, number)
+}
+
+func (mod11_2) Generate(number string) (string, error) {
+	if !isDigits(number) {
+		return "", ErrInvalidCharacter
+	}
+
+	p := 0
+	for n := 0; n < len(number); n++ {
+		p = (p + int(number[n]-'0')) * 2 % 11
+	}
+
+	return mod11Character((12 - p) % 11), nil
+}
+
+// -----------------------
+
+type mod11_10 struct{}
+
+func (m mod11_10) Verify(number string) bool {
+	return verifyLast(m, number)
+}
+
+func (mod11_10) Generate(number string) (string, error) {
+	if !isDigits(number) {
+		return "", ErrInvalidCharacter
+	}
+
+	product := 10
+	for n := 0; n < len(number); n++ {
+		sum := (int(number[n]-'0') + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = 2 * sum % 11
+	}
+
+	return strconv.Itoa((11 - product) % 10), nil
+}
+
+// -----------------------
+
+type mod97_10 struct{}
+
+// remainder of the number divided by 97, letters are two digit numbers: A = 10, B = 11, ...
+func mod97(number string) (int, error) {
+	remainder := 0
+	for _, c := range number {
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return 0, ErrInvalidCharacter
+		}
+	}
+	return remainder, nil
+}
+
+func (mod97_10) Verify(number string) bool {
+	remainder, err := mod97(number)
+	return err == nil && len(number) >= 3 && remainder == 1
+}
+
+func (mod97_10) Generate(number string) (string, error) {
+	if len(number) == 0 {
+		return "", ErrInvalidCharacter
+	}
+
+	remainder, err := mod97(number + "00")
+	if err != nil {
+		return "", err
+	}
+
+	check := strconv.Itoa(98 - remainder)
+	if len(check) == 1 {
+		check = "0" + check
+	}
+	return check, nil
+}
@@ -0,0 +1,88 @@
+package checkdigit
+
+import (
+	"testing"
+)
+
+func Test_verify(t *testing.T) {
+	var list = []struct {
+		name        string
+		algorithm   Algorithm
+		number      string
+		expectation bool
+	}{
+		{"Luhn", Luhn, "79927398713", true},
+		{"Luhn", Luhn, "4242424242424242", true},
+		{"Luhn", Luhn, "79927398710", false},
+		{"Luhn", Luhn, "79927398731", false},
+		{"Luhn", Luhn, "7992739871a", false},
+		{"Luhn", Luhn, "", false},
+		{"Verhoeff", Verhoeff, "2363", true},
+		{"Verhoeff", Verhoeff, "2364", false},
+		{"Verhoeff", Verhoeff, "3263", false}, // transposition
+		{"Damm", Damm, "5724", true},
+		{"Damm", Damm, "5727", false},
+		{"Damm", Damm, "7524", false},
+		{"GS1", GS1, "4006381333931", true}, // EAN-13
+		{"GS1", GS1, "036000291452", true},  // UPC-A
+		{"GS1", GS1, "96385074", true},      // EAN-8
+		{"GS1", GS1, "4006381333932", false},
+		{"Mod11", Mod11, "0306406152", true}, // ISBN-10
+		{"Mod11", Mod11, "080442957X", true},
+		{"Mod11", Mod11, "03178471", true}, // ISSN
+		{"Mod11", Mod11, "0306406153", false},
+		{"Mod11", Mod11, "0804429579", false},
+		{"Mod11_2", Mod11_2, "0000000218250097", true}, // ORCID
+		{"Mod11_2", Mod11_2, "000000021694233X", true},
+		{"Mod11_2", Mod11_2, "0000000218250098", false},
+		{"Mod11_10", Mod11_10, "136695976", true},
+		{"Mod11_10", Mod11_10, "136695977", false},
+		{"Mod97_10", Mod97_10, "79444", true},
+		{"Mod97_10", Mod97_10, "WEST12345698765432GB82", true}, // IBAN, country and check digits moved to the end
+		{"Mod97_10", Mod97_10, "WEST12345698765432GB83", false},
+		{"Mod97_10", Mod97_10, "west12345698765432GB82", false},
+	}
+
+	for _, l := range list {
+		if r := l.algorithm.Verify(l.number); r != l.expectation {
+			t.Errorf("%s.Verify(%s): [%t]. Expected: %t", l.name, l.number, r, l.expectation)
+		}
+	}
+}
+
+func Test_generate(t *testing.T) {
+	var list = []struct {
+		name      string
+		algorithm Algorithm
+		number    string
+		check     string
+	}{
+		{"Luhn", Luhn, "7992739871", "3"},
+		{"Verhoeff", Verhoeff, "236", "3"},
+		{"Damm", Damm, "572", "4"},
+		{"GS1", GS1, "400638133393", "1"},
+		{"Mod11", Mod11, "080442957", "X"},
+		{"Mod11_2", Mod11_2, "000000021694233", "X"},
+		{"Mod11_10", Mod11_10, "13669597", "6"},
+		{"Mod97_10", Mod97_10, "794", "44"},
+		{"Mod97_10", Mod97_10, "WEST12345698765432GB", "82"},
+	}
+
+	for _, l := range list {
+		check, err := l.algorithm.Generate(l.number)
+		if err != nil || check != l.check {
+			t.Errorf("%s.Generate(%s): [%s, %v]. Expected: %s", l.name, l.number, check, err, l.check)
+		}
+
+		// every generated number verifies
+		if !l.algorithm.Verify(l.number + check) {
+			t.Errorf("%s.Verify(%s%s): [false]. Expected: true", l.name, l.number, check)
+		}
+	}
+
+	for _, a := range []Algorithm{Luhn, Verhoeff, Damm, GS1, Mod11, Mod11_2, Mod11_10} {
+		if _, err := a.Generate("12a"); err != ErrInvalidCharacter {
+			t.Errorf("Generate(12a): [%v]. Expected: %v", err, ErrInvalidCharacter)
+		}
+	}
+}
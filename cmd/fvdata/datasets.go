@@ -0,0 +1,360 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	fv "github.com/dholtzmann/formvalidator"
+)
+
+/*
+	How to read and check one dataset
+
+	parse - reads the upstream file, returns the raw entries and the version written in the file ("" if it has none)
+	normalize - applied to every entry, before removing duplicates
+	valid - entries that fail it are an error, the upstream file is probably not the expected one
+	keep - entries that fail it are skipped, like passwords shorter than 8 characters
+	extra - entries added even if the upstream file dropped them, like withdrawn currency codes still found in old records
+*/
+type dataset struct {
+	format    string
+	parse     func(io.Reader) ([]string, string, error)
+	normalize func(string) string
+	valid     func(string) bool
+	keep      func(string) bool
+	extra     []string
+}
+
+var datasets = map[string]dataset{
+	"common-password-list-minlength-8-characters": {
+		format:    "one password per line (SecLists)",
+		parse:     parseLines,
+		normalize: func(s string) string { return s },
+		valid:     func(s string) bool { return !strings.ContainsAny(s, "\r\n") },
+		keep:      func(s string) bool { return len(s) >= 8 },
+	},
+	"disposable-email-domains": {
+		format:    "one domain per line, '#' comments (disposable_email_blocklist.conf)",
+		parse:     parseLines,
+		normalize: strings.ToLower,
+		valid:     isDomain,
+	},
+	"disposable-email-domains-wildcards": {
+		format:    "one domain per line, '#' comments, also blocks the subdomains",
+		parse:     parseLines,
+		normalize: strings.ToLower,
+		valid:     isDomain,
+	},
+	"email-role-accounts": {
+		format:    "one local part per line, '#' comments",
+		parse:     parseLines,
+		normalize: strings.ToLower,
+		valid:     isLocalPart,
+	},
+	"free-email-providers": {
+		format:    "one domain per line, '#' comments",
+		parse:     parseLines,
+		normalize: strings.ToLower,
+		valid:     isDomain,
+	},
+	"iso3166-country-codes": {
+		format:    "CSV with an \"alpha-2\" column (ISO-3166-Countries-with-Regional-Codes all.csv), or iso-codes iso_3166-1.json",
+		parse:     parseCountries,
+		normalize: strings.ToLower,
+		valid:     func(s string) bool { return len(s) == 2 && isLetters(s) },
+	},
+	"iso4217-currency-codes": {
+		format:    "ISO 4217 list one XML (list-one.xml), or iso-codes iso_4217.json",
+		parse:     parseCurrencies,
+		normalize: strings.ToLower,
+		valid:     func(s string) bool { return len(s) == 3 && isLetters(s) },
+		extra:     []string{"mro", "std", "vef"}, // replaced by mru, stn, ves (2018), CurrencyCode() kept accepting them
+	},
+	"tlds": {
+		format:    "IANA tlds-alpha-by-domain.txt, or the ICANN section of public_suffix_list.dat",
+		parse:     parseTLDs,
+		normalize: toASCIILabel,
+		valid:     isLabel,
+	},
+}
+
+func datasetNames() []string {
+	var names []string
+	for name := range datasets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// normalize, skip, validate, remove duplicates and sort
+func (d dataset) clean(raw []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var entries []string
+	for _, e := range append(raw[:len(raw):len(raw)], d.extra...) {
+		e = d.normalize(strings.TrimSpace(e))
+		if len(e) == 0 || seen[e] || (d.keep != nil && !d.keep(e)) {
+			continue
+		}
+		if !d.valid(e) {
+			return nil, fmt.Errorf("invalid entry %q", e)
+		}
+
+		seen[e] = true
+		entries = append(entries, e)
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no entries")
+	}
+
+	sort.Strings(entries)
+	return entries, nil
+}
+
+// -----------------------
+
+// one entry per line, blank lines and lines starting with '#' are skipped
+func parseLines(r io.Reader) ([]string, string, error) {
+	var entries []string
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	return entries, "", s.Err()
+}
+
+// "# Version 2024061000, Last Updated Mon Jun 10 07:07:01 2024 UTC" on the first line, then one top level domain per line
+func parseIANATLDs(r io.Reader) ([]string, string, error) {
+	br := bufio.NewReader(r)
+	first, err := br.ReadString('\n')
+	if err != nil && err != io.EOF {
+		return nil, "", err
+	}
+
+	var version string
+	if rest, ok := strings.CutPrefix(strings.TrimSpace(first), "# Version "); ok {
+		version, _, _ = strings.Cut(rest, ",")
+	} else if !strings.HasPrefix(first, "#") {
+		return nil, "", fmt.Errorf("expected a \"# Version\" header")
+	}
+
+	entries, _, err := parseLines(br)
+	return entries, version, err
+}
+
+// the Public Suffix List if it has an ICANN section, otherwise the IANA list
+func parseTLDs(r io.Reader) ([]string, string, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if bytes.Contains(b, []byte(pslBeginICANN)) {
+		return parsePublicSuffixList(bytes.NewReader(b))
+	}
+	return parseIANATLDs(bytes.NewReader(b))
+}
+
+const (
+	pslBeginICANN = "// ===BEGIN ICANN DOMAINS==="
+	pslEndICANN   = "// ===END ICANN DOMAINS==="
+)
+
+/*
+	The top level domains of the ICANN section of the Public Suffix List, the last label of each rule ("*.ck", "!www.ck" -> "ck")
+	The private section (blogspot.com, ...) is not read, the file has no version, use -version.
+*/
+func parsePublicSuffixList(r io.Reader) ([]string, string, error) {
+	var entries []string
+	icann := false
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case line == pslBeginICANN:
+			icann = true
+		case line == pslEndICANN:
+			return entries, "", nil
+		case icann && len(line) > 0 && !strings.HasPrefix(line, "//"):
+			rule, _, _ := strings.Cut(line, " ")
+			entries = append(entries, rule[strings.LastIndex(rule, ".")+1:])
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, "", err
+	}
+	return nil, "", fmt.Errorf("expected %q", pslEndICANN)
+}
+
+// iso-codes JSON if the file starts with '{', otherwise CSV
+func parseCountries(r io.Reader) ([]string, string, error) {
+	br := bufio.NewReader(r)
+	if isJSON(br) {
+		return parseISOCodesJSON(br, "3166-1", "alpha_2")
+	}
+	return parseCountryCSV(br)
+}
+
+// iso-codes JSON if the file starts with '{', otherwise XML
+func parseCurrencies(r io.Reader) ([]string, string, error) {
+	br := bufio.NewReader(r)
+	if isJSON(br) {
+		return parseISOCodesJSON(br, "4217", "alpha_3")
+	}
+	return parseISO4217XML(br)
+}
+
+func isJSON(br *bufio.Reader) bool {
+	for {
+		c, err := br.ReadByte()
+		if err != nil {
+			return false
+		}
+		if !strings.ContainsRune(" \t\r\n", rune(c)) {
+			br.UnreadByte()
+			return c == '{'
+		}
+	}
+}
+
+/*
+	The files of the iso-codes package (/usr/share/iso-codes/json), {"3166-1": [{"alpha_2": "DE", ...}, ...]}
+	They have no version, use -version with the version of the package.
+*/
+func parseISOCodesJSON(r io.Reader, standard, field string) ([]string, string, error) {
+	var doc map[string][]map[string]string
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, "", err
+	}
+
+	list, ok := doc[standard]
+	if !ok {
+		return nil, "", fmt.Errorf("no %q list", standard)
+	}
+
+	var entries []string
+	for _, e := range list {
+		entries = append(entries, e[field])
+	}
+	return entries, "", nil
+}
+
+// the column "alpha-2" of a CSV file with a header row
+func parseCountryCSV(r io.Reader) ([]string, string, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, "", err
+	}
+	if len(records) == 0 {
+		return nil, "", fmt.Errorf("empty file")
+	}
+
+	column := -1
+	for n, h := range records[0] {
+		if strings.EqualFold(strings.TrimSpace(h), "alpha-2") {
+			column = n
+		}
+	}
+	if column < 0 {
+		return nil, "", fmt.Errorf("no \"alpha-2\" column")
+	}
+
+	var entries []string
+	for _, r := range records[1:] {
+		if column < len(r) {
+			entries = append(entries, r[column])
+		}
+	}
+	return entries, "", nil
+}
+
+// <ISO_4217 Pblshd="2024-06-25"><CcyTbl><CcyNtry><Ccy>EUR</Ccy>...
+func parseISO4217XML(r io.Reader) ([]string, string, error) {
+	var doc struct {
+		Published string `xml:"Pblshd,attr"`
+		Entries   []struct {
+			Code string `xml:"Ccy"`
+		} `xml:"CcyTbl>CcyNtry"`
+	}
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, "", err
+	}
+
+	var entries []string
+	for _, e := range doc.Entries {
+		entries = append(entries, e.Code) // blank for "No universal currency", skipped
+	}
+	return entries, doc.Published, nil
+}
+
+// -----------------------
+
+func isLetters(s string) bool {
+	for _, c := range s {
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// punycode for IDNs, "рф" -> "xn--p1ai", an entry that cannot be encoded is left for isLabel() to reject
+func toASCIILabel(s string) string {
+	if a, err := fv.ToASCIIDomain(s); err == nil {
+		return a
+	}
+	return s
+}
+
+// lower case letters, digits and hyphens, "xn--p1ai" for IDNs
+func isLabel(s string) bool {
+	if len(s) == 0 || len(s) > 63 || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// at least two labels
+func isDomain(s string) bool {
+	labels := strings.Split(s, ".")
+	if len(labels) < 2 || len(s) > 253 {
+		return false
+	}
+	for _, l := range labels {
+		if !isLabel(l) {
+			return false
+		}
+	}
+	return true
+}
+
+// the local part of an e-mail address, without dots at the ends
+func isLocalPart(s string) bool {
+	if len(s) == 0 || len(s) > 64 || s[0] == '.' || s[len(s)-1] == '.' {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || strings.ContainsRune(".-_", c)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_parsers(t *testing.T) {
+	var list = []struct {
+		name     string
+		input    string
+		entries  string
+		version  string
+		hasError bool
+	}{
+		{"tlds", "# Version 2024061000, Last Updated Mon Jun 10 07:07:01 2024 UTC\nAAA\nCOM\nXN--P1AI\n", "aaa,com,xn--p1ai", "2024061000", false},
+		{"tlds", "COM\nNET\n", "", "", true}, // no header, not the IANA file
+		{"tlds", "# Version 1\nCOM\nBAD_TLD\n", "", "", true},
+		{"tlds", "// header\n\n// ===BEGIN ICANN DOMAINS===\n\n// ac\nac\ncom.ac\n*.ck\n!www.ck\nрф\n// ===END ICANN DOMAINS===\n// ===BEGIN PRIVATE DOMAINS===\nblogspot.example\n", "ac,ck,xn--p1ai", "", false},
+		{"tlds", "// ===BEGIN ICANN DOMAINS===\ncom\n", "", "", true}, // cut off
+		{"iso3166-country-codes", "name,alpha-2,alpha-3\nGermany,DE,DEU\nFrance,FR,FRA\nGermany,DE,DEU\n", "de,fr", "", false},
+		{"iso3166-country-codes", "name,alpha-3\nGermany,DEU\n", "", "", true},
+		{"iso3166-country-codes", "name,alpha-2\nGermany,DEU\n", "", "", true},
+		{"iso3166-country-codes", `{"3166-1": [{"alpha_2": "DE", "alpha_3": "DEU", "name": "Germany"}, {"alpha_2": "FR", "name": "France"}]}`, "de,fr", "", false},
+		{"iso3166-country-codes", `{"3166-2": [{"code": "DE-BE"}]}`, "", "", true},
+		{"iso4217-currency-codes", `<?xml version="1.0" encoding="UTF-8"?><ISO_4217 Pblshd="2024-06-25"><CcyTbl>
+			<CcyNtry><CtryNm>GERMANY</CtryNm><Ccy>EUR</Ccy></CcyNtry>
+			<CcyNtry><CtryNm>FRANCE</CtryNm><Ccy>EUR</Ccy></CcyNtry>
+			<CcyNtry><CtryNm>ANTARCTICA</CtryNm><CcyNm>No universal currency</CcyNm></CcyNtry>
+			<CcyNtry><CtryNm>BRAZIL</CtryNm><Ccy>BRL</Ccy></CcyNtry>
+			</CcyTbl></ISO_4217>`, "brl,eur,mro,std,vef", "2024-06-25", false}, // the withdrawn codes are kept
+		{"iso4217-currency-codes", `<ISO_4217><CcyTbl><CcyNtry><Ccy>EURO</Ccy></CcyNtry></CcyTbl></ISO_4217>`, "", "", true},
+		{"iso4217-currency-codes", ` {"4217": [{"alpha_3": "EUR", "name": "Euro", "numeric": "978"}, {"alpha_3": "BRL"}, {"alpha_3": "MRU"}]}`, "brl,eur,mro,mru,std,vef", "", false},
+		{"disposable-email-domains", "# comment\n33Mail.com\n\n0-mail.com\n33mail.com\n", "0-mail.com,33mail.com", "", false},
+		{"disposable-email-domains", "localhost\n", "", "", true},
+		{"email-role-accounts", "Admin\nno-reply\nnoreply\n", "admin,no-reply,noreply", "", false},
+		{"common-password-list-minlength-8-characters", "123456\npassword\nPassword1\npassword\nqwerty,123\n", "Password1,password,qwerty,123", "", false},
+		{"free-email-providers", "", "", "", true}, // empty
+	}
+
+	for _, l := range list {
+		d := datasets[l.name]
+		raw, version, err := d.parse(strings.NewReader(l.input))
+		var entries []string
+		if err == nil {
+			entries, err = d.clean(raw)
+		}
+
+		if (err != nil) != l.hasError {
+			t.Errorf("%s(%q): Error[%v]. Expected error: %t", l.name, l.input, err, l.hasError)
+			continue
+		}
+
+		if err == nil && (strings.Join(entries, ",") != l.entries || version != l.version) {
+			t.Errorf("%s(%q): [%v, %s]. Expected: %s, %s", l.name, l.input, entries, version, l.entries, l.version)
+		}
+	}
+}
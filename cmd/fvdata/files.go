@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// a row of 'data/versions.csv', see formvalidator.DatasetVersion
+type version struct {
+	version string
+	source  string
+	updated string
+	entries int
+}
+
+var versionsHeader = []string{"name", "version", "source", "updated", "entries"}
+
+// a missing file is an empty list
+func readVersions(path string) (map[string]version, error) {
+	versions := make(map[string]version)
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return versions, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	for n, r := range records {
+		if n == 0 || len(r) != len(versionsHeader) {
+			continue
+		}
+
+		entries, err := strconv.Atoi(r[4])
+		if err != nil {
+			return nil, err
+		}
+		versions[r[0]] = version{r[1], r[2], r[3], entries}
+	}
+	return versions, nil
+}
+
+// sorted by dataset name, so the file only changes where a dataset changed
+func writeVersions(path string, versions map[string]version) error {
+	var names []string
+	for name := range versions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	records := [][]string{versionsHeader}
+	for _, name := range names {
+		v := versions[name]
+		records = append(records, []string{name, v.version, v.source, v.updated, strconv.Itoa(v.entries)})
+	}
+
+	return writeCSV(path, records)
+}
+
+// the format mustFormatCSVBytes() reads, one line of comma separated entries
+func writeList(path string, entries []string) error {
+	return writeCSV(path, [][]string{entries})
+}
+
+// written to a temporary file first, an error does not leave half a file
+func writeCSV(path string, records [][]string) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(records); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_regenerate(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "tlds-alpha-by-domain.txt")
+	os.WriteFile(src, []byte("# Version 2024061000, Last Updated Mon Jun 10 07:07:01 2024 UTC\nNET\nCOM\n"), 0644)
+
+	v, err := regenerate("tlds", src, dir)
+	if err != nil || v.version != "2024061000" || v.entries != 2 {
+		t.Fatalf("regenerate(tlds): [%+v, %v]", v, err)
+	}
+
+	if b, _ := os.ReadFile(filepath.Join(dir, "tlds.csv")); string(b) != "com,net\n" {
+		t.Errorf("regenerate(tlds): wrote %q. Expected: \"com,net\\n\"", b)
+	}
+
+	if _, err := regenerate("unknown", src, dir); err == nil {
+		t.Errorf("regenerate(unknown): no error")
+	}
+
+	// the old file is kept if the new entries are not valid
+	os.WriteFile(src, []byte("# Version 2\nBAD_TLD\n"), 0644)
+	if _, err := regenerate("tlds", src, dir); err == nil {
+		t.Errorf("regenerate(BAD_TLD): no error")
+	}
+	if b, _ := os.ReadFile(filepath.Join(dir, "tlds.csv")); string(b) != "com,net\n" {
+		t.Errorf("regenerate(BAD_TLD): changed the file %q", b)
+	}
+}
+
+func Test_versions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "versions.csv")
+
+	versions, err := readVersions(path)
+	if err != nil || len(versions) != 0 {
+		t.Fatalf("readVersions(missing): [%v, %v]", versions, err)
+	}
+
+	versions["tlds"] = version{"2024061000", "tlds-alpha-by-domain.txt", "2024-06-10", 1450}
+	versions["iso4217-currency-codes"] = version{"2024-06-25", "list-one.xml", "2024-06-26", 178}
+	if err := writeVersions(path, versions); err != nil {
+		t.Fatalf("writeVersions(): %v", err)
+	}
+
+	expected := "name,version,source,updated,entries\niso4217-currency-codes,2024-06-25,list-one.xml,2024-06-26,178\ntlds,2024061000,tlds-alpha-by-domain.txt,2024-06-10,1450\n"
+	if b, _ := os.ReadFile(path); string(b) != expected {
+		t.Errorf("writeVersions(): %q. Expected: %q", b, expected)
+	}
+
+	if r, err := readVersions(path); err != nil || r["tlds"] != versions["tlds"] || len(r) != 2 {
+		t.Errorf("readVersions(): [%v, %v]", r, err)
+	}
+}
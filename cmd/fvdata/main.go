@@ -0,0 +1,114 @@
+/*
+	fvdata regenerates the lists in 'data/' from local copies of their upstream files
+
+	usage: fvdata [-data ./data] [-version v] [-source s] dataset=file ...
+
+	go run ./cmd/fvdata tlds=tlds-alpha-by-domain.txt iso4217-currency-codes=list-one.xml
+
+	The entries are normalized, deduplicated, sorted and validated, then written in the one line format of 'data/*.csv'.
+	The version, source and date are recorded in 'data/versions.csv', the library reports them with Dataset.Version().
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func main() {
+	dataDir := flag.String("data", "data", "directory with the dataset files")
+	version := flag.String("version", "", "version of the upstream files, if they do not have one (default: today)")
+	source := flag.String("source", "", "where the upstream files come from (default: the file name)")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: fvdata [flags] dataset=file ...\n\ndatasets:\n")
+		for _, name := range datasetNames() {
+			fmt.Fprintf(flag.CommandLine.Output(), "  %-45s %s\n", name, datasets[name].format)
+		}
+		fmt.Fprintf(flag.CommandLine.Output(), "\nflags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	today := time.Now().UTC().Format("2006-01-02")
+	versionsPath := filepath.Join(*dataDir, "versions.csv")
+
+	versions, err := readVersions(versionsPath)
+	if err != nil {
+		fail(err)
+	}
+
+	for _, arg := range flag.Args() {
+		name, path, ok := strings.Cut(arg, "=")
+		if !ok {
+			fail(fmt.Errorf("%s: expected dataset=file", arg))
+		}
+
+		v, err := regenerate(name, path, *dataDir)
+		if err != nil {
+			fail(err)
+		}
+
+		if len(v.version) == 0 {
+			v.version = *version
+		}
+		if len(v.version) == 0 {
+			v.version = today
+		}
+		v.source = *source
+		if len(v.source) == 0 {
+			v.source = filepath.Base(path)
+		}
+		v.updated = today
+
+		versions[name] = v
+		fmt.Printf("%s: %d entries, version %s\n", name, v.entries, v.version)
+	}
+
+	if err := writeVersions(versionsPath, versions); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "fvdata:", err)
+	os.Exit(1)
+}
+
+// read the upstream file, write 'data/NAME.csv'
+func regenerate(name, path, dataDir string) (version, error) {
+	d, ok := datasets[name]
+	if !ok {
+		return version{}, fmt.Errorf("unknown dataset %q, see fvdata -h", name)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return version{}, err
+	}
+	defer f.Close()
+
+	raw, upstreamVersion, err := d.parse(f)
+	if err != nil {
+		return version{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	entries, err := d.clean(raw)
+	if err != nil {
+		return version{}, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if err := writeList(filepath.Join(dataDir, name+".csv"), entries); err != nil {
+		return version{}, err
+	}
+
+	return version{version: upstreamVersion, entries: len(entries)}, nil
+}
@@ -0,0 +1,332 @@
+package formvalidator
+
+import (
+	"bytes"
+	"embed"
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// the lists in 'data/', one line of comma separated entries each, and 'data/versions.csv'
+//
+//go:embed data/*.csv
+var dataFiles embed.FS
+
+func mustAsset(name string) []byte {
+	b, err := dataFiles.ReadFile(name)
+	if err != nil {
+		panic(err.Error())
+	}
+	return b
+}
+
+/*
+	A list of entries that rules check against, (country codes, disposable domains, ...)
+	The bundled lists are embedded in the package, replace or extend them at runtime without waiting for a release:
+
+	err := fv.CommonPasswords.LoadFile("/etc/myapp/passwords.csv")
+	fv.CountryCodes.Add("xk")
+
+	Safe for concurrent use, rules see the old or the new entries, never a partial list.
+*/
+type Dataset struct {
+	name     string
+	mu       sync.RWMutex
+	mode     DatasetMode
+	entries  []string // nil for DatasetBloom
+	set      stringSet
+	bloom    *bloomFilters
+	version  DatasetVersion
+	onChange func() // rebuilds lists made from the dataset, like disposableList
+}
+
+/*
+	How a Dataset looks up entries
+
+	DatasetMap - a hash set, the fastest, the default
+	DatasetSorted - binary search over the sorted entries, no memory besides the entries
+	DatasetBloom - a Bloom filter, about 2 bytes per entry, the entries are not kept (Entries() is nil)
+
+	With DatasetBloom, Contains() is true for about 1 in 1000 entries that are not in the list.
+	Fine for rejecting weak passwords, not for lists where a false match locks users out.
+*/
+type DatasetMode int
+
+const (
+	DatasetMap DatasetMode = iota
+	DatasetSorted
+	DatasetBloom
+)
+
+// false positive rate of DatasetBloom
+const datasetBloomRate = 0.001
+
+/*
+	Where the entries of a dataset come from, recorded in 'data/versions.csv' by the command cmd/fvdata
+
+	Version - of the upstream file ("20230209.2326" for the public suffix list of top level domains), or the date it was generated
+	Source - the upstream file, Updated - when the dataset was generated (YYYY-MM-DD), Entries - how many it had
+	It is empty for hand-maintained lists and after the entries are changed at runtime.
+*/
+type DatasetVersion struct {
+	Name    string
+	Version string
+	Source  string
+	Updated string
+	Entries int
+}
+
+func NewDataset(name string, entries ...string) *Dataset {
+	d := &Dataset{name: name}
+	d.Replace(entries...)
+	return d
+}
+
+// name of the dataset, the file name in 'data/' without ".csv" for the bundled ones
+func (d *Dataset) Name() string {
+	return d.name
+}
+
+// replace all the entries, blank entries are skipped, spaces around entries are removed
+func (d *Dataset) Replace(entries ...string) {
+	list := cleanEntries(entries)
+
+	d.mu.Lock()
+	d.index(list)
+	d.version = DatasetVersion{Name: d.name}
+	d.mu.Unlock()
+
+	d.changed()
+}
+
+// add entries to the dataset, the index is built again (a DatasetBloom filter grows instead), add large lists at once
+func (d *Dataset) Add(entries ...string) {
+	list := cleanEntries(entries)
+
+	d.mu.Lock()
+	if d.mode == DatasetBloom {
+		for _, e := range list {
+			d.bloom.add(e)
+		}
+	} else {
+		d.index(append(d.entries[:len(d.entries):len(d.entries)], list...)) // copy, Entries() may share the old slice
+	}
+	d.version = DatasetVersion{Name: d.name}
+	d.mu.Unlock()
+
+	d.changed()
+}
+
+/*
+	Change how entries are looked up, see DatasetMode
+	Switching from DatasetBloom loses the entries, set the mode before loading a list:
+
+	fv.CommonPasswords.SetMode(fv.DatasetBloom)
+	err := fv.CommonPasswords.LoadFile("/var/lib/myapp/breached-passwords.csv")
+
+	DatasetBloom is rejected with ErrDatasetMode for DisposableDomains, DisposableWildcards and FreeEmailProviders,
+	the e-mail rules build their lists from the entries.
+*/
+func (d *Dataset) SetMode(mode DatasetMode) error {
+	if mode == DatasetBloom && d.onChange != nil {
+		return ErrDatasetMode
+	}
+
+	d.mu.Lock()
+	d.mode = mode
+	d.index(d.entries)
+	d.mu.Unlock()
+
+	d.changed()
+	return nil
+}
+
+var ErrDatasetMode = errors.New("Dataset needs its entries, DatasetBloom is not allowed!")
+
+// builds the lookup for the mode, called with the lock held, LIST is owned by the dataset
+func (d *Dataset) index(list []string) {
+	d.entries, d.set, d.bloom = nil, nil, nil
+
+	switch d.mode {
+	case DatasetSorted:
+		sort.Strings(list)
+		d.entries = list
+	case DatasetBloom:
+		d.bloom = newBloomFilters(len(list), datasetBloomRate)
+		for _, e := range list {
+			d.bloom.add(e)
+		}
+	default:
+		d.entries = list
+		d.set = newStringSet(list)
+	}
+}
+
+/*
+	Replace the entries with the ones read from R, see ReadList()
+	The dataset is unchanged if there is an error.
+*/
+func (d *Dataset) Load(r io.Reader) error {
+	list, err := ReadList(r)
+	if err != nil {
+		return err
+	}
+
+	d.Replace(list...)
+	return nil
+}
+
+// Load() from a file
+func (d *Dataset) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return d.Load(f)
+}
+
+// Is the entry in the dataset? (case sensitive)
+func (d *Dataset) Contains(entry string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	switch d.mode {
+	case DatasetSorted:
+		n := sort.SearchStrings(d.entries, entry)
+		return n < len(d.entries) && d.entries[n] == entry
+	case DatasetBloom:
+		return d.bloom.contains(entry)
+	}
+	return d.set.has(entry)
+}
+
+// the entries, do not modify the slice, nil for DatasetBloom
+func (d *Dataset) Entries() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.entries
+}
+
+// where the entries come from, see DatasetVersion
+func (d *Dataset) Version() DatasetVersion {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.version
+}
+
+func (d *Dataset) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	if d.mode == DatasetBloom {
+		return d.bloom.length
+	}
+	return len(d.entries)
+}
+
+func (d *Dataset) changed() {
+	if d.onChange != nil {
+		d.onChange()
+	}
+}
+
+func cleanEntries(entries []string) []string {
+	list := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e = strings.TrimSpace(e); len(e) > 0 {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+/*
+	Read the entries of a list, the format of the files in 'data/' (one line, comma separated)
+	One entry per line also works, lines starting with '#' are comments, blank entries are skipped.
+	Use it to extend a dataset: list, err := ReadList(r); fv.DisposableDomains.Add(list...)
+*/
+func ReadList(r io.Reader) ([]string, error) {
+	c := csv.NewReader(r)
+	c.Comment = '#'
+	c.FieldsPerRecord = -1
+	c.TrimLeadingSpace = true
+
+	var list []string
+	for {
+		record, err := c.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, record...)
+	}
+
+	return cleanEntries(list), nil
+}
+
+/*
+	The bundled datasets, the rules that use them:
+
+	CountryCodes - lower case ISO-3166 (CountryCode(), BIC())
+	CurrencyCodes - lower case ISO-4217 (CurrencyCode())
+	CommonPasswords - 8 characters or more (NotCommonPassword())
+	DisposableDomains, DisposableWildcards - (Email(false), EmailAddress() with BlockDisposable), the wildcards also match subdomains
+	TopLevelDomains - lower case, without the dot (WebURL() with RequireTLD, EmailTypo())
+	RoleAccounts - local parts like "admin" (EmailPolicy())
+	FreeEmailProviders - (EmailPolicy())
+*/
+var (
+	CountryCodes        = NewDataset("iso3166-country-codes")
+	CurrencyCodes       = NewDataset("iso4217-currency-codes")
+	CommonPasswords     = NewDataset("common-password-list-minlength-8-characters")
+	DisposableDomains   = NewDataset("disposable-email-domains")
+	DisposableWildcards = NewDataset("disposable-email-domains-wildcards")
+	TopLevelDomains     = NewDataset("tlds")
+	RoleAccounts        = NewDataset("email-role-accounts")
+	FreeEmailProviders  = NewDataset("free-email-providers")
+)
+
+// the datasets loaded from 'data/' by init()
+var bundledDatasets = []*Dataset{CountryCodes, CurrencyCodes, CommonPasswords, DisposableDomains, DisposableWildcards, TopLevelDomains, RoleAccounts, FreeEmailProviders}
+
+// the bundled datasets, to report their versions: for _, d := range fv.Datasets() { log.Println(d.Version()) }
+func Datasets() []*Dataset {
+	return append([]*Dataset(nil), bundledDatasets...)
+}
+
+/*
+	The rows of 'data/versions.csv': name,version,source,updated,entries
+	The first row is the header.
+*/
+func mustReadVersions(b []byte) map[string]DatasetVersion {
+	records, err := csv.NewReader(bytes.NewReader(b)).ReadAll()
+	if err != nil {
+		panic(err.Error())
+	}
+
+	versions := make(map[string]DatasetVersion)
+	for n, r := range records {
+		if n == 0 || len(r) != 5 {
+			continue
+		}
+
+		entries, err := strconv.Atoi(r[4])
+		if err != nil {
+			panic(err.Error())
+		}
+		versions[r[0]] = DatasetVersion{r[0], r[1], r[2], r[3], entries}
+	}
+	return versions
+}
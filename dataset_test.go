@@ -0,0 +1,311 @@
+package formvalidator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func Test_readList(t *testing.T) {
+	var list = []struct {
+		input    string
+		expected []string
+	}{
+		{"", nil},
+		{"de,fr,it\n", []string{"de", "fr", "it"}},
+		{"de, fr , it", []string{"de", "fr", "it"}},
+		{"de\nfr\n\nit\n", []string{"de", "fr", "it"}},
+		{"# countries\nde,fr\n,,it", []string{"de", "fr", "it"}},
+	}
+
+	for _, l := range list {
+		r, err := ReadList(strings.NewReader(l.input))
+		if err != nil || strings.Join(r, "|") != strings.Join(l.expected, "|") {
+			t.Errorf("ReadList(%q): [%v, %v]. Expected: %v", l.input, r, err, l.expected)
+		}
+	}
+
+	if _, err := ReadList(strings.NewReader(`"de,fr`)); err == nil {
+		t.Errorf("ReadList(unterminated quote): no error")
+	}
+}
+
+func Test_dataset(t *testing.T) {
+	d := NewDataset("test", "a", " b ", "")
+	if d.Name() != "test" || d.Len() != 2 || !d.Contains("b") || d.Contains("") {
+		t.Errorf("NewDataset(): %v", d.Entries())
+	}
+
+	old := d.Entries()
+	d.Add("c")
+	if !d.Contains("c") || len(old) != 2 {
+		t.Errorf("Dataset.Add(): %v, old: %v", d.Entries(), old)
+	}
+
+	d.Replace("x")
+	if d.Contains("a") || !d.Contains("x") {
+		t.Errorf("Dataset.Replace(): %v", d.Entries())
+	}
+
+	if err := d.Load(strings.NewReader("y,z")); err != nil || d.Len() != 2 || !d.Contains("z") {
+		t.Errorf("Dataset.Load(): [%v, %v]", d.Entries(), err)
+	}
+
+	if err := d.Load(strings.NewReader(`"broken`)); err == nil || !d.Contains("z") {
+		t.Errorf("Dataset.Load(broken): [%v, %v]. Expected: error, unchanged", d.Entries(), err)
+	}
+
+	path := filepath.Join(t.TempDir(), "list.csv")
+	os.WriteFile(path, []byte("one,two\n"), 0600)
+	if err := d.LoadFile(path); err != nil || strings.Join(d.Entries(), ",") != "one,two" {
+		t.Errorf("Dataset.LoadFile(): [%v, %v]", d.Entries(), err)
+	}
+
+	if err := d.LoadFile(filepath.Join(t.TempDir(), "missing.csv")); err == nil {
+		t.Errorf("Dataset.LoadFile(missing): no error")
+	}
+}
+
+func Test_bundledDatasets(t *testing.T) {
+	for _, d := range bundledDatasets {
+		if d.Len() == 0 {
+			t.Errorf("Dataset %s is empty", d.Name())
+		}
+	}
+
+	if !CountryCodes.Contains("de") || !CurrencyCodes.Contains("eur") || !CommonPasswords.Contains("password123") || !TopLevelDomains.Contains("com") {
+		t.Errorf("bundled datasets are missing entries")
+	}
+}
+
+func Test_datasetRebuildsDomainLists(t *testing.T) {
+	old := DisposableDomains.Entries()
+	defer DisposableDomains.Replace(old...)
+
+	rule := EmailAddress(EmailOptions{BlockDisposable: true})
+	if e, _ := rule.Validate([]string{"bob@new-throwaway.example"}, testMessages); e != nil {
+		t.Errorf("EmailAddress(new-throwaway.example): Error[%s]. Expected: none", messageKey(e))
+	}
+
+	DisposableDomains.Add("new-throwaway.example")
+	if e, _ := rule.Validate([]string{"bob@new-throwaway.example"}, testMessages); messageKey(e) != "email_disposable" {
+		t.Errorf("EmailAddress(new-throwaway.example): Error[%s]. Expected: email_disposable", messageKey(e))
+	}
+
+	if e, _ := rule.Validate([]string{"bob@foo.33mail.com"}, testMessages); messageKey(e) != "email_disposable" {
+		t.Errorf("EmailAddress(foo.33mail.com): Error[%s]. Expected: email_disposable, the wildcards are kept", messageKey(e))
+	}
+
+	old2 := FreeEmailProviders.Entries()
+	defer FreeEmailProviders.Replace(old2...)
+
+	FreeEmailProviders.Replace("isp.example")
+	policy := EmailPolicy(EmailPolicyOptions{BlockFreeMail: true})
+	if e, _ := policy.Validate([]string{"bob@gmail.com"}, testMessages); e != nil {
+		t.Errorf("EmailPolicy(gmail.com): Error[%s]. Expected: none, the list was replaced", messageKey(e))
+	}
+	if e, _ := policy.Validate([]string{"bob@isp.example"}, testMessages); messageKey(e) != "email_free" {
+		t.Errorf("EmailPolicy(isp.example): Error[%s]. Expected: email_free", messageKey(e))
+	}
+}
+
+func Test_loadDisposableList(t *testing.T) {
+	oldDomains, oldWildcards := DisposableDomains.Entries(), DisposableWildcards.Entries()
+	defer DisposableWildcards.Replace(oldWildcards...)
+	defer DisposableDomains.Replace(oldDomains...)
+
+	// the blank entry is skipped, the dataset's slice has room for one more
+	DisposableDomains.Replace("a.example", "b.example", "")
+	entries := DisposableDomains.Entries()
+	DisposableWildcards.Replace("c.example")
+	if spare := entries[:cap(entries)]; len(spare) > len(entries) && len(spare[len(entries)]) > 0 {
+		t.Errorf("loadDisposableList(): wrote %q into the entries of DisposableDomains", spare[len(entries)])
+	}
+
+	// rebuilds from several goroutines, the last one sees every entry
+	var wg sync.WaitGroup
+	for n := 0; n < 20; n++ {
+		wg.Add(2)
+		go func(n int) {
+			defer wg.Done()
+			DisposableDomains.Add(fmt.Sprintf("d%d.example", n))
+		}(n)
+		go func(n int) {
+			defer wg.Done()
+			DisposableWildcards.Add(fmt.Sprintf("w%d.example", n))
+		}(n)
+	}
+	wg.Wait()
+
+	for n := 0; n < 20; n++ {
+		for _, domain := range []string{fmt.Sprintf("d%d.example", n), fmt.Sprintf("w%d.example", n), fmt.Sprintf("mx.w%d.example", n)} {
+			if _, ok := disposableList.Match(domain); !ok {
+				t.Errorf("disposableList.Match(%s): false. Expected: true", domain)
+			}
+		}
+	}
+}
+
+func Test_datasetVersion(t *testing.T) {
+	v := mustReadVersions([]byte("name,version,source,updated,entries\ntlds,2024061000,tlds-alpha-by-domain.txt,2024-06-10,1450\n"))
+	if v["tlds"] != (DatasetVersion{"tlds", "2024061000", "tlds-alpha-by-domain.txt", "2024-06-10", 1450}) || len(v) != 1 {
+		t.Errorf("mustReadVersions(): %v", v)
+	}
+
+	for _, d := range Datasets() {
+		if d.Version().Name != d.Name() {
+			t.Errorf("Dataset %s: Version() %+v", d.Name(), d.Version())
+		}
+	}
+
+	d := NewDataset("test", "a")
+	d.version = DatasetVersion{"test", "1", "test.txt", "2024-06-10", 1}
+	d.Add("b")
+	if d.Version() != (DatasetVersion{Name: "test"}) {
+		t.Errorf("Dataset.Add(): Version() %+v. Expected: empty, changed at runtime", d.Version())
+	}
+}
+
+func Test_datasetModes(t *testing.T) {
+	for _, mode := range []DatasetMode{DatasetMap, DatasetSorted, DatasetBloom} {
+		d := NewDataset("test")
+		d.SetMode(mode)
+		d.Replace("kiwi", "apple", "mango")
+		d.Add("banana")
+
+		for _, e := range []string{"kiwi", "apple", "mango", "banana"} {
+			if !d.Contains(e) {
+				t.Errorf("Dataset(mode %d).Contains(%s): false", mode, e)
+			}
+		}
+
+		if d.Contains("cherry") || d.Contains("") || d.Len() != 4 {
+			t.Errorf("Dataset(mode %d): Contains(cherry) %t, Len() %d", mode, d.Contains("cherry"), d.Len())
+		}
+
+		if (mode == DatasetBloom) != (d.Entries() == nil) {
+			t.Errorf("Dataset(mode %d).Entries(): %v", mode, d.Entries())
+		}
+	}
+
+	// switching keeps the entries, except from DatasetBloom
+	d := NewDataset("test", "b", "a")
+	d.SetMode(DatasetSorted)
+	if strings.Join(d.Entries(), ",") != "a,b" || !d.Contains("b") {
+		t.Errorf("Dataset.SetMode(DatasetSorted): %v", d.Entries())
+	}
+	d.SetMode(DatasetMap)
+	if !d.Contains("a") {
+		t.Errorf("Dataset.SetMode(DatasetMap): %v", d.Entries())
+	}
+
+	calls := 0
+	d.onChange = func() { calls++ }
+	if d.SetMode(DatasetSorted); calls != 1 {
+		t.Errorf("Dataset.SetMode(): onChange called %d times. Expected: 1", calls)
+	}
+
+	// the e-mail rules need the entries, their lists are built again
+	if err := DisposableDomains.SetMode(DatasetBloom); err != ErrDatasetMode || DisposableDomains.Entries() == nil {
+		t.Errorf("DisposableDomains.SetMode(DatasetBloom): %v. Expected: ErrDatasetMode", err)
+	}
+
+	defer DisposableDomains.SetMode(DatasetMap)
+	if err := DisposableDomains.SetMode(DatasetSorted); err != nil {
+		t.Errorf("DisposableDomains.SetMode(DatasetSorted): %v", err)
+	}
+	if err, _ := Email(false).Validate([]string{"someone@mailinator.com"}, testMessages); err == nil {
+		t.Errorf("Email(false) after DisposableDomains.SetMode(DatasetSorted): a disposable domain is accepted")
+	}
+}
+
+func Test_datasetBloomAdd(t *testing.T) {
+	list := make([]string, 100000)
+	for n := range list {
+		list[n] = "password" + strconv.Itoa(n)
+	}
+
+	d := NewDataset("test")
+	d.SetMode(DatasetBloom)
+	d.Add(list...) // the filter was sized for 0 entries
+	d.Add("one more")
+
+	if !d.Contains("password99999") || !d.Contains("one more") || d.Len() != 100001 {
+		t.Errorf("Dataset(DatasetBloom).Add(): entries are missing, Len() %d", d.Len())
+	}
+
+	falsePositives := 0
+	for n := 0; n < 100000; n++ {
+		if d.Contains("correct horse battery staple " + strconv.Itoa(n)) {
+			falsePositives++
+		}
+	}
+	if falsePositives > 300 { // about 0.2% after growing, the hash seed changes every run
+		t.Errorf("Dataset(DatasetBloom).Add(): %d false positives in 100000", falsePositives)
+	}
+}
+
+// -----------------------
+
+// a list the size of the bundled disposable domains, the entry is near the end for inSlice
+func benchmarkList() ([]string, string) {
+	list := make([]string, 20000)
+	for n := range list {
+		list[n] = "domain" + strconv.Itoa(n) + ".example"
+	}
+	return list, list[len(list)-10]
+}
+
+func BenchmarkInSlice(b *testing.B) {
+	list, entry := benchmarkList()
+	for n := 0; n < b.N; n++ {
+		inSlice(list, entry)
+	}
+}
+
+func benchmarkDataset(b *testing.B, mode DatasetMode) {
+	list, entry := benchmarkList()
+	d := NewDataset("benchmark")
+	d.SetMode(mode)
+	d.Replace(list...)
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		d.Contains(entry)
+	}
+}
+
+func BenchmarkDatasetMap(b *testing.B)    { benchmarkDataset(b, DatasetMap) }
+func BenchmarkDatasetSorted(b *testing.B) { benchmarkDataset(b, DatasetSorted) }
+func BenchmarkDatasetBloom(b *testing.B)  { benchmarkDataset(b, DatasetBloom) }
+
+func BenchmarkNotCommonPassword(b *testing.B) {
+	rule := NotCommonPassword()
+	field := []string{"correct horse battery staple"}
+	for n := 0; n < b.N; n++ {
+		rule.Validate(field, testMessages)
+	}
+}
+
+func BenchmarkCountryCode(b *testing.B) {
+	rule := CountryCode()
+	field := []string{"zw"}
+	for n := 0; n < b.N; n++ {
+		rule.Validate(field, testMessages)
+	}
+}
+
+func BenchmarkInListSingle(b *testing.B) {
+	list, entry := benchmarkList()
+	rule := InListSingle(list)
+	field := []string{entry}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		rule.Validate(field, testMessages)
+	}
+}
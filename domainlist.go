@@ -0,0 +1,198 @@
+package formvalidator
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+/*
+	A set of domain names, matched by label from the top level domain down (a reversed-label trie)
+	"example.com" matches only example.com, "*.example.com" matches its subdomains, but not example.com itself
+
+	Safe for concurrent use, lists can be extended while the validator runs.
+*/
+type DomainList struct {
+	name string
+	mu   sync.RWMutex
+	root domainNode
+}
+
+type domainNode struct {
+	children map[string]*domainNode
+	exact    string // entry that matches this domain, "" for none
+	wildcard string // entry that matches the subdomains
+}
+
+func NewDomainList(name string, domains ...string) *DomainList {
+	l := &DomainList{name: name}
+	l.Add(domains...)
+	return l
+}
+
+// name of the list, reported in ListMatch
+func (l *DomainList) Name() string {
+	return l.name
+}
+
+// add domains or "*.domain" patterns, case insensitive
+func (l *DomainList) Add(domains ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.root.add(domains)
+}
+
+// replace all the entries of the list, lookups see the old or the new list, never a partial one
+func (l *DomainList) Replace(domains ...string) {
+	var root domainNode
+	root.add(domains)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.root = root
+}
+
+func (root *domainNode) add(domains []string) {
+	for _, d := range domains {
+		entry := strings.ToLower(strings.TrimSpace(d))
+		domain, wildcard := strings.CutPrefix(entry, "*.")
+		if len(domain) == 0 {
+			continue
+		}
+
+		n := root
+		labels := strings.Split(domain, ".")
+		for i := len(labels) - 1; i >= 0; i-- {
+			if n.children == nil {
+				n.children = make(map[string]*domainNode)
+			}
+			next, ok := n.children[labels[i]]
+			if !ok {
+				next = &domainNode{}
+				n.children[labels[i]] = next
+			}
+			n = next
+		}
+
+		if wildcard {
+			n.wildcard = entry
+		} else {
+			n.exact = entry
+		}
+	}
+}
+
+// the entries of the list, lower case and sorted, to save a list before changing it: defer l.Replace(l.Entries()...)
+func (l *DomainList) Entries() []string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	var entries []string
+	l.root.collect(&entries)
+	sort.Strings(entries)
+	return entries
+}
+
+func (n *domainNode) collect(entries *[]string) {
+	for _, e := range []string{n.exact, n.wildcard} {
+		if len(e) > 0 {
+			*entries = append(*entries, e)
+		}
+	}
+	for _, c := range n.children {
+		c.collect(entries)
+	}
+}
+
+// the entry that matches the domain, "*.example.com" for "mail.example.com"
+func (l *DomainList) Match(domain string) (string, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	labels := strings.Split(strings.ToLower(strings.TrimSuffix(domain, ".")), ".")
+
+	n := &l.root
+	for i := len(labels) - 1; i >= 0; i-- {
+		if len(n.wildcard) > 0 { // more labels left, a subdomain
+			return n.wildcard, true
+		}
+
+		next, ok := n.children[labels[i]]
+		if !ok {
+			return "", false
+		}
+		n = next
+	}
+
+	return n.exact, len(n.exact) > 0
+}
+
+/*
+	Which list rejected an entry, the extra data of "email_disposable" errors
+	Prints as the domain, so messages can use "%s"
+*/
+type ListMatch struct {
+	List  string // DomainList.Name()
+	Entry string // "33mail.com" or "*.33mail.com"
+}
+
+func (m ListMatch) String() string {
+	return strings.TrimPrefix(m.Entry, "*.")
+}
+
+/*
+	Lists for Email() and EmailAddress(EmailOptions{BlockDisposable: true})
+
+	EmailAllowlist - domains that are never rejected as disposable, checked first
+	EmailBlocklist - your own additions, EmailBlocklist.Add("*.example.net")
+	The bundled disposable domains are the datasets DisposableDomains and DisposableWildcards,
+	a domain in DisposableWildcards also blocks all of its subdomains.
+*/
+var (
+	EmailAllowlist = NewDomainList("allowlist")
+	EmailBlocklist = NewDomainList("blocklist")
+	disposableList = NewDomainList("disposable")
+	freeEmailList  = NewDomainList("free") // FreeEmailProviders, see EmailPolicy()
+)
+
+// one rebuild at a time, so a rebuild with older entries cannot finish last
+var loadListsMu sync.Mutex
+
+// rebuilds disposableList, called when DisposableDomains or DisposableWildcards change
+func loadDisposableList() {
+	loadListsMu.Lock()
+	defer loadListsMu.Unlock()
+
+	wildcards := DisposableWildcards.Entries()
+	domains := DisposableDomains.Entries()
+	domains = append(domains[:len(domains):len(domains)], wildcards...) // copy, the slice belongs to the dataset
+	for _, d := range wildcards {
+		domains = append(domains, "*."+d)
+	}
+	disposableList.Replace(domains...)
+}
+
+// rebuilds freeEmailList, called when FreeEmailProviders changes
+func loadFreeEmailList() {
+	loadListsMu.Lock()
+	defer loadListsMu.Unlock()
+
+	freeEmailList.Replace(FreeEmailProviders.Entries()...)
+}
+
+// is the domain of an e-mail address disposable? (ASCII domain, see parseEmail)
+func matchDisposable(domain string) (ListMatch, bool) {
+	if _, ok := EmailAllowlist.Match(domain); ok {
+		return ListMatch{}, false
+	}
+
+	for _, l := range []*DomainList{EmailBlocklist, disposableList} {
+		if entry, ok := l.Match(domain); ok {
+			return ListMatch{l.Name(), entry}, true
+		}
+	}
+
+	return ListMatch{}, false
+}
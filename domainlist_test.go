@@ -0,0 +1,90 @@
+package formvalidator
+
+import (
+	"strings"
+	"testing"
+)
+
+func Test_domainList(t *testing.T) {
+	l := NewDomainList("test", "example.com", "*.example.net", "Mail.Example.ORG")
+
+	var list = []struct {
+		domain string
+		entry  string
+		match  bool
+	}{
+		{"example.com", "example.com", true},
+		{"EXAMPLE.com.", "example.com", true},
+		{"www.example.com", "", false},
+		{"example.net", "", false},
+		{"a.example.net", "*.example.net", true},
+		{"a.b.example.net", "*.example.net", true},
+		{"badexample.net", "", false},
+		{"mail.example.org", "mail.example.org", true},
+		{"example.org", "", false},
+		{"com", "", false},
+		{"", "", false},
+	}
+
+	for _, l2 := range list {
+		entry, ok := l.Match(l2.domain)
+		if ok != l2.match || entry != l2.entry {
+			t.Errorf("DomainList.Match(%s): [%s, %t]. Expected: %s, %t", l2.domain, entry, ok, l2.entry, l2.match)
+		}
+	}
+
+	if entries := strings.Join(l.Entries(), ","); entries != "*.example.net,example.com,mail.example.org" {
+		t.Errorf("DomainList.Entries(): [%s]. Expected: *.example.net,example.com,mail.example.org", entries)
+	}
+}
+
+func Test_emailDisposable(t *testing.T) {
+	blocklist, allowlist := EmailBlocklist.Entries(), EmailAllowlist.Entries()
+	defer EmailBlocklist.Replace(blocklist...)
+	defer EmailAllowlist.Replace(allowlist...)
+
+	EmailBlocklist.Add("*.blocked.example")
+	EmailAllowlist.Add("dropmail.me")
+
+	var list = []struct {
+		field string
+		key   string
+		match ListMatch
+	}{
+		{"bob@example.com", "", ListMatch{}},
+		{"bob@33mail.com", "email_disposable", ListMatch{"disposable", "33mail.com"}},
+		{"bob@foo.33mail.com", "email_disposable", ListMatch{"disposable", "*.33mail.com"}},
+		{"bob@FOO.33mail.com", "email_disposable", ListMatch{"disposable", "*.33mail.com"}},
+		{"bob@dropmail.me", "", ListMatch{}}, // allowlist
+		{"bob@a.blocked.example", "email_disposable", ListMatch{"blocklist", "*.blocked.example"}},
+		{"bob@blocked.example", "", ListMatch{}},
+	}
+
+	for _, l := range list {
+		e, data := EmailAddress(EmailOptions{BlockDisposable: true}).Validate([]string{l.field}, testMessages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("emailDisposable(%s): Error[%s]. Expected: %s", l.field, key, l.key)
+		}
+
+		if e != nil && (len(data) != 1 || data[0] != l.match) {
+			t.Errorf("emailDisposable(%s): Data[%v]. Expected: %v", l.field, data, l.match)
+		}
+
+		// Email() keeps its message, without the match
+		key := ""
+		if len(l.key) > 0 {
+			key = "email"
+		}
+		if e, data := Email(false).Validate([]string{l.field}, testMessages); messageKey(e) != key || data != nil {
+			t.Errorf("email(%s): [%s, %v]. Expected: %s, no data", l.field, messageKey(e), data, key)
+		}
+
+		if e, _ := Email(true).Validate([]string{l.field}, testMessages); e != nil {
+			t.Errorf("emailDisposable(%s, allowed): Error[%s]. Expected: none", l.field, messageKey(e))
+		}
+	}
+
+	if s := (ListMatch{"disposable", "*.33mail.com"}).String(); s != "33mail.com" {
+		t.Errorf("ListMatch.String(): [%s]. Expected: 33mail.com", s)
+	}
+}
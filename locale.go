@@ -0,0 +1,238 @@
+package formvalidator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+	A Locale describes how numbers and dates are written for a language and region.
+
+	FormError.Error() uses it to render the extra data of an error message, so "%f" becomes "0,01" for German and "0.01" for English.
+
+	Layouts use the reference time of package "time" (Mon Jan 2 15:04:05 MST 2006).
+*/
+type Locale struct {
+	Name             string // BCP 47 language tag, "de-DE"
+	DecimalSeparator string
+	GroupSeparator   string // thousands separator
+	DateLayout       string
+	TimeLayout       string
+	DateTimeLayout   string
+}
+
+// locales matching the translated error messages in 'locales/locales.go'
+var (
+	LocaleEnUS = &Locale{"en-US", ".", ",", "01/02/2006", "3:04:05 PM", "01/02/2006 3:04:05 PM"}
+	LocaleDeDE = &Locale{"de-DE", ",", ".", "02.01.2006", "15:04:05", "02.01.2006 15:04:05"}
+	LocaleEsES = &Locale{"es-ES", ",", ".", "02/01/2006", "15:04:05", "02/01/2006 15:04:05"}
+	LocaleFrFR = &Locale{"fr-FR", ",", "\u202f", "02/01/2006", "15:04:05", "02/01/2006 15:04:05"}
+	LocaleItIT = &Locale{"it-IT", ",", ".", "02/01/2006", "15:04:05", "02/01/2006 15:04:05"}
+	LocalePtBR = &Locale{"pt-BR", ",", ".", "02/01/2006", "15:04:05", "02/01/2006 15:04:05"}
+)
+
+/*
+	Works like fmt.Sprintf, but numbers and dates in the arguments are written the way the locale does.
+
+	Floats without an explicit precision ("%f", "%v") use the shortest representation: 0.01 instead of 0.010000
+	Integers are grouped with "%v" (quantities: 1,000 characters), "%d" writes them as they are (port 65535)
+	time.Time values ("%s", "%v") use the locale's date, time, or date-time layout
+*/
+func (l *Locale) Sprintf(format string, a ...interface{}) string {
+	args := make([]interface{}, len(a))
+	for n, v := range a {
+		args[n] = localized{l, v}
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+/*
+	Formats an integer or decimal string (digits, optional sign and '.' decimal point) with the locale's separators
+	Example: "-1234567.5" -> "-1.234.567,5" (de-DE)
+*/
+func (l *Locale) FormatNumber(number string) string {
+	sign := ""
+	if strings.HasPrefix(number, "-") || strings.HasPrefix(number, "+") {
+		sign, number = number[:1], number[1:]
+	}
+
+	integer, fraction := number, ""
+	if d := strings.IndexByte(number, '.'); d >= 0 {
+		integer, fraction = number[:d], number[d+1:]
+	}
+
+	// group the integer part in threes, from the right
+	var grouped strings.Builder
+	for n, c := range integer {
+		if n > 0 && (len(integer)-n)%3 == 0 {
+			grouped.WriteString(l.GroupSeparator)
+		}
+		grouped.WriteRune(c)
+	}
+
+	if len(fraction) > 0 {
+		return sign + grouped.String() + l.DecimalSeparator + fraction
+	}
+	return sign + grouped.String()
+}
+
+var ErrInvalidNumber = errors.New("String is not a number in the format of the locale!")
+
+/*
+	Rewrites a number written for the locale into the format strconv.ParseFloat() expects
+	Example: "-1.234,5" -> "-1234.5" (de-DE)
+
+	Group separators are optional, but if used they must separate groups of three digits: "1.234.567" not "12.34"
+*/
+func (l *Locale) NormalizeNumber(number string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(number, "-") || strings.HasPrefix(number, "+") {
+		sign, number = number[:1], number[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(number, l.DecimalSeparator)
+	if hasFraction && (len(fraction) == 0 || !isDigits(fraction)) {
+		return "", ErrInvalidNumber
+	}
+
+	// spaces used as group separators are interchangeable, browsers and keyboards do not type narrow no-break spaces
+	groups := []string{integer}
+	if isSpace(l.GroupSeparator) {
+		groups = strings.Split(spaceReplacer.Replace(integer), " ")
+	} else if len(l.GroupSeparator) > 0 {
+		groups = strings.Split(integer, l.GroupSeparator)
+	}
+
+	for n, g := range groups {
+		if !isDigits(g) && !(len(groups) == 1 && len(g) == 0 && hasFraction) { // ",5" is allowed
+			return "", ErrInvalidNumber
+		}
+		if len(groups) > 1 && ((n == 0 && len(g) > 3) || (n > 0 && len(g) != 3)) {
+			return "", ErrInvalidNumber
+		}
+	}
+
+	if hasFraction {
+		return sign + strings.Join(groups, "") + "." + fraction, nil
+	}
+	return sign + strings.Join(groups, ""), nil
+}
+
+// parse a float64 written for the locale, see NormalizeNumber()
+func (l *Locale) ParseFloat(number string) (float64, error) {
+	n, err := l.NormalizeNumber(number)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(n, 64)
+}
+
+// parse an integer written for the locale, see NormalizeNumber()
+func (l *Locale) ParseInt(number string) (int, error) {
+	n, err := l.NormalizeNumber(number)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(n)
+}
+
+// digits [0-9] only, not empty
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return len(s) > 0
+}
+
+var spaceReplacer = strings.NewReplacer("\u00a0", " ", "\u202f", " ")
+
+func isSpace(s string) bool {
+	return s == " " || s == "\u00a0" || s == "\u202f"
+}
+
+// pick the layout that fits the value, times without a date (year 0) or dates without a time (midnight)
+func (l *Locale) formatTime(t time.Time) string {
+	if t.Year() == 0 {
+		return t.Format(l.TimeLayout)
+	}
+
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		return t.Format(l.DateLayout)
+	}
+
+	return t.Format(l.DateTimeLayout)
+}
+
+// wraps an argument of Locale.Sprintf, implements fmt.Formatter
+type localized struct {
+	locale *Locale
+	value  interface{}
+}
+
+func (v localized) Format(s fmt.State, verb rune) {
+	var str string
+
+	switch x := v.value.(type) {
+	case float64:
+		str = v.formatFloat(s, verb, x)
+	case float32:
+		str = v.formatFloat(s, verb, float64(x))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		if verb != 'v' { // "%d" is not grouped, ports, years, and codes are numbers too
+			fmt.Fprintf(s, fmt.FormatString(s, verb), x)
+			return
+		}
+		str = v.locale.FormatNumber(fmt.Sprintf("%d", x))
+	case time.Time:
+		if verb != 's' && verb != 'v' {
+			fmt.Fprintf(s, fmt.FormatString(s, verb), x)
+			return
+		}
+		str = v.locale.formatTime(x)
+	default:
+		fmt.Fprintf(s, fmt.FormatString(s, verb), x)
+		return
+	}
+
+	if s.Flag('+') && !strings.HasPrefix(str, "-") {
+		str = "+" + str
+	}
+
+	// padding
+	if width, ok := s.Width(); ok {
+		if s.Flag('-') {
+			str = fmt.Sprintf("%-*s", width, str)
+		} else {
+			str = fmt.Sprintf("%*s", width, str)
+		}
+	}
+
+	fmt.Fprint(s, str)
+}
+
+func (v localized) formatFloat(s fmt.State, verb rune, f float64) string {
+	switch verb {
+	case 'f', 'F', 'v':
+	case 'g', 'G':
+		if _, ok := s.Precision(); ok { // significant digits, not decimals
+			return strings.Replace(fmt.Sprintf(fmt.FormatString(s, verb), f), ".", v.locale.DecimalSeparator, 1)
+		}
+	default:
+		return fmt.Sprintf(fmt.FormatString(s, verb), f)
+	}
+
+	precision := -1 // shortest representation
+	if p, ok := s.Precision(); ok {
+		precision = p
+	}
+
+	return v.locale.FormatNumber(strconv.FormatFloat(f, 'f', precision, 64))
+}
@@ -0,0 +1,60 @@
+package formvalidator
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_localeSprintf(t *testing.T) {
+	var list = []struct {
+		locale      *Locale
+		format      string
+		data        []interface{}
+		expectation string
+	}{
+		{LocaleEnUS, "between %f - %f", []interface{}{0.01, 100.0}, "between 0.01 - 100"},
+		{LocaleDeDE, "zwischen %f und %f", []interface{}{-10.5, 1234.5}, "zwischen -10,5 und 1.234,5"},
+		{LocaleDeDE, "%.2f", []interface{}{-10.5}, "-10,50"},
+		{LocaleFrFR, "%.2f", []interface{}{1234567.891}, "1\u202f234\u202f567,89"},
+		{LocaleEnUS, "%v - %v", []interface{}{1000, 2500000}, "1,000 - 2,500,000"},
+		{LocaleEnUS, "port %d", []interface{}{65535}, "port 65535"},
+		{LocalePtBR, "%d", []interface{}{uint32(12)}, "12"},
+		{LocaleEsES, "%v", []interface{}{float32(2.5)}, "2,5"},
+		{LocaleDeDE, "%6.1f|%-6d|", []interface{}{3.14159, 42}, "   3,1|42    |"},
+		{LocaleEnUS, "%+d", []interface{}{5}, "+5"},
+		{LocaleEnUS, "%x", []interface{}{255}, "ff"},
+		{LocaleDeDE, "%s", []interface{}{"DD-MM-YYYY"}, "DD-MM-YYYY"},
+		{LocaleDeDE, "%s", []interface{}{time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC)}, "31.12.1990"},
+		{LocaleEnUS, "%s", []interface{}{time.Date(1990, 12, 31, 0, 0, 0, 0, time.UTC)}, "12/31/1990"},
+		{LocaleEnUS, "%s", []interface{}{time.Date(1990, 12, 31, 14, 23, 56, 0, time.UTC)}, "12/31/1990 2:23:56 PM"},
+		{LocaleItIT, "%v", []interface{}{time.Date(0, 1, 1, 14, 23, 56, 0, time.UTC)}, "14:23:56"},
+	}
+
+	for _, l := range list {
+		result := l.locale.Sprintf(l.format, l.data...)
+		if result != l.expectation {
+			t.Errorf("%s.Sprintf(%q, %v): Result[%q]. Expected: %q", l.locale.Name, l.format, l.data, result, l.expectation)
+		}
+	}
+}
+
+func Test_localeFormatNumber(t *testing.T) {
+	var list = []struct {
+		number      string
+		expectation string
+	}{
+		{"0", "0"},
+		{"123", "123"},
+		{"1234", "1.234"},
+		{"-123456", "-123.456"},
+		{"1234567.125", "1.234.567,125"},
+		{"+0.5", "+0,5"},
+	}
+
+	for _, l := range list {
+		result := LocaleDeDE.FormatNumber(l.number)
+		if result != l.expectation {
+			t.Errorf("FormatNumber(%s): Result[%s]. Expected: %s", l.number, result, l.expectation)
+		}
+	}
+}
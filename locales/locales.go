@@ -13,288 +13,694 @@ import (
 */
 
 var enUSErrors = map[string]string{
-	"account":          "The e-mail or password you entered is incorrect.",
-	"alpha_num":        "This field may only contain letters and numbers.",
-	"boolean":          "This field must be true or false.",
-	"captcha":          "The characters you entered did not match the word verification. Please retry.",
-	"city":             "We could not find that city. Please check your spelling.",
-	"country_code":     "Please select a valid country.",
-	"credit_card":      "Please enter a valid credit card number.",
-	"csrf":             "There was an error submitting the form. Please retry.",
-	"currency_code":    "Please enter a valid currency code.",
-	"date":             "This field must be in a date format (DD-MM-YYYY) [Ex: 31-12-1990]",
-	"date_time":        "This field must be in a date-time format (DD-MM-YYYY HH:MM:SS) [Ex: 31-12-1990 14:23:56]",
-	"delimiter_min":    "Entries must be at least %d characters long.",
-	"delimiter_max":    "Entries cannot be more than %d characters long.",
-	"duplicate":        "This field cannot contain duplicate entries.",
-	"email":            "Please enter a valid e-mail address.",
-	"email_taken":      "That e-mail address is already in use.",
-	"float":            "This field must be a floating point number. (Example: -10.50)",
-	"float_range":      "This field must be between %f - %f.",
-	"in_list":          "Please make a selection.",
-	"int_range":        "This field must be between %d - %d.",
-	"isbn":             "Please enter a valid ISBN.",
-	"json":             "This field must contain valid JSON (Javascript object notation).",
-	"latitude":         "Latitude must be between -90.0 degrees and 90.0 degrees.",
-	"longitude":        "Longitude must be between -180.0 degrees and 180.0 degrees.",
-	"multiple_entries": "This field may only contain one entry.",
-	"not_in_list":      "This field contains an invalid entry.",
-	"numeric":          "This field must contain enter only numbers.",
-	"required":         "This field is required.",
-	"slug":             "This field must contain at least one letter or number.",
-	"string_matches":   "Fields did not match.",
-	"string_max":       "This field cannot be more than %d characters long.",
-	"string_min":       "This field must be at least %d characters long.",
-	"time":             "This field must be in a time format (HH:MM:SS) [Ex: 14:23:56]",
-	"unselected_field": "Please select this field.",
-	"utf8_letter_num":  "This field may only contain letters and numbers (Character set: UTF8).",
-	"uuid":             "Please enter a valid UUID.",
-	"weak_password":    "Please use a stronger password.",
-	"web_request_uri":  "Please enter a valid Web URI.",
+	"account":           "The e-mail or password you entered is incorrect.",
+	"alpha_num":         "This field may only contain letters and numbers.",
+	"bic":               "Please enter a valid BIC/SWIFT code.",
+	"bic_iban_mismatch": "The BIC does not match the country of the IBAN.",
+	"boolean":           "This field must be true or false.",
+	"breach_unchecked":  "The password could not be checked. Please try again later.",
+	"captcha":           "The characters you entered did not match the word verification. Please retry.",
+	"card_brand":        "We do not accept this card type.",
+	"card_expired":      "This card has expired.",
+	"card_expiry":       "Please enter a valid expiration date.",
+	"check_digit":       "This number is not valid, please check it for typos.",
+	"cidr":              "Please enter a valid network in CIDR notation. [Ex: 192.168.0.0/16]",
+	"cidr_prefix":       "The network prefix must be between /%d and /%d.",
+	"city":              "We could not find that city. Please check your spelling.",
+	"cnpj":              "Please enter a valid CNPJ.",
+	"country_code":      "Please select a valid country.",
+	"cpf":               "Please enter a valid CPF.",
+	"credit_card":       "Please enter a valid credit card number.",
+	"csrf":              "There was an error submitting the form. Please retry.",
+	"currency_code":     "Please enter a valid currency code.",
+	"cvv":               "Please enter a valid security code.",
+	"date":              "This field must be in a date format (DD-MM-YYYY) [Ex: 31-12-1990]",
+	"date_future":       "This date cannot be in the future.",
+	"date_layout":       "This field must be in a date format (%s) [Ex: %s]",
+	"date_max":          "This date cannot be after %s.",
+	"date_min":          "This date cannot be before %s.",
+	"date_past":         "This date cannot be in the past.",
+	"date_range_max":    "The date range cannot be longer than %d days.",
+	"date_range_min":    "The date range must be at least %d days long.",
+	"date_range_order":  "The end date cannot be before the start date.",
+	"date_time":         "This field must be in a date-time format (DD-MM-YYYY HH:MM:SS) [Ex: 31-12-1990 14:23:56]",
+	"date_time_layout":  "This field must be in a date-time format (%s) [Ex: %s]",
+	"delimiter_min":     "Entries must be at least %d characters long.",
+	"delimiter_max":     "Entries cannot be more than %d characters long.",
+	"duplicate":         "This field cannot contain duplicate entries.",
+	"email":             "Please enter a valid e-mail address.",
+	"email_deliverable": "The domain %s does not accept e-mail.",
+	"email_disposable":  "E-mail addresses from %s are not accepted.",
+	"email_domain":      "E-mail addresses from %s are not allowed.",
+	"email_free":        "Please use your work e-mail address, not one from %s.",
+	"email_length":      "This e-mail address is too long.",
+	"email_role":        "Please use a personal e-mail address, not a shared one like %s@.",
+	"email_taken":       "That e-mail address is already in use.",
+	"email_typo":        "Did you mean %s?",
+	"float":             "This field must be a floating point number. (Example: -10.50)",
+	"float_range":       "This field must be between %f - %f.",
+	"gtin":              "Please enter a valid barcode number (GTIN/EAN).",
+	"host_port":         "Please enter a host and port. [Ex: example.com:443]",
+	"hostname":          "Please enter a valid host name.",
+	"iban":              "Please enter a valid IBAN.",
+	"in_list":           "Please make a selection.",
+	"int_range":         "This field must be between %d - %d.",
+	"ip":                "Please enter a valid IP address.",
+	"ip_public":         "Please enter a public IP address.",
+	"isbn":              "Please enter a valid ISBN.",
+	"ismn":              "Please enter a valid ISMN.",
+	"issn":              "Please enter a valid ISSN.",
+	"json":              "This field must contain valid JSON (Javascript object notation).",
+	"ksuid":             "Please enter a valid KSUID.",
+	"latitude":          "Latitude must be between -90.0 degrees and 90.0 degrees.",
+	"longitude":         "Longitude must be between -180.0 degrees and 180.0 degrees.",
+	"mac":               "Please enter a valid MAC address.",
+	"max_age":           "You cannot be more than %d years old.",
+	"min_age":           "You must be at least %d years old.",
+	"multiple_entries":  "This field may only contain one entry.",
+	"not_in_list":       "This field contains an invalid entry.",
+	"numeric":           "This field must contain enter only numbers.",
+	"password_breached": "This password has appeared %d times in data breaches. Please choose another one.",
+	"phone":             "Please enter a valid phone number.",
+	"phone_type":        "This type of phone number is not accepted.",
+	"port":              "Please enter a port number between %d and %d.",
+	"postal_code":       "Please enter a valid postal code.",
+	"required":          "This field is required.",
+	"slug":              "This field must contain at least one letter or number.",
+	"ssn":               "Please enter a valid Social Security number.",
+	"string_matches":    "Fields did not match.",
+	"string_max":        "This field cannot be more than %d characters long.",
+	"string_min":        "This field must be at least %d characters long.",
+	"tax_id":            "Please enter a valid tax identification number.",
+	"time":              "This field must be in a time format (HH:MM:SS) [Ex: 14:23:56]",
+	"time_between":      "This time must be between %s and %s.",
+	"time_layout":       "This field must be in a time format (%s) [Ex: %s]",
+	"ulid":              "Please enter a valid ULID.",
+	"unselected_field":  "Please select this field.",
+	"upc":               "Please enter a valid UPC.",
+	"url":               "Please enter a valid web address.",
+	"url_host":          "This web address is not allowed.",
+	"url_length":        "The web address cannot be more than %d characters long.",
+	"url_private":       "This web address points to a private network.",
+	"url_resolve":       "We could not find the server of this web address.",
+	"utf8_letter_num":   "This field may only contain letters and numbers (Character set: UTF8).",
+	"uuid":              "Please enter a valid UUID.",
+	"vat_number":        "Please enter a valid VAT number.",
+	"weak_password":     "Please use a stronger password.",
+	"web_request_uri":   "Please enter a valid Web URI.",
+	"weekday":           "This day of the week is not available.",
 }
 
 var deDeErrors = map[string]string{
-	"account":          "Die eingegebene E-Mail-Adresse oder das Passwort ist falsch.",
-	"alpha_num":        "Dieses Feld kann nur Buchstaben und Ziffern enthalten.",
-	"boolean":          "Dieses Feld muss wahr oder falsch sein.",
-	"captcha":          "Die eingegebenen Zeichen stimmen nicht mit der Sicherheitsabfrage überein. Bitte versuchen Sie es erneut.",
-	"city":             "Wir haben diese Stadt nicht gefunden. Bitte überprüfen Sie die Schreibweise.",
-	"country_code":     "Bitte wählen Sie ein gültiges Land aus.",
-	"credit_card":      "Geben Sie bitte eine gültige Kreditkarten-Nummer ein.",
-	"csrf":             "Es gab einen Fehler beim Absenden des Formulars. Bitte versuchen Sie es erneut.",
-	"currency_code":    "",
-	"date":             "",
-	"date_time":        "",
-	"delimiter_min":    "",
-	"delimiter_max":    "",
-	"duplicate":        "Dieses Feld kann keine doppelten Einträge enthalten.",
-	"email":            "Geben Sie bitte eine gültige E-Mail Adresse ein.",
-	"email_taken":      "Dieser Nutzername wird bereits verwendet. Anderen Nutzernamen versuchen?",
-	"float":            "Dieses Feld muss eine Gleitkommazahl sein. (Beispiel:-10,50)",
-	"float_range":      "Geben Sie bitte einen Wert zwischen %f und %f ein.",
-	"in_list":          "Bitte treffen Sie eine Auswahl.",
-	"int_range":        "Geben Sie bitte einen Wert zwischen %d und %d ein.",
-	"isbn":             "",
-	"json":             "",
-	"latitude":         "Breitengrad muss zwischen -90,0 Grad und 90,0 Grad sein.",
-	"longitude":        "Längengrad muss zwischen -180,0 Grad und 180,0 Grad sein.",
-	"multiple_entries": "",
-	"not_in_list":      "Dieses Feld enthält einen ungültigen Eintrag.",
-	"numeric":          "Geben Sie bitte nur Ziffern ein.",
-	"required":         "Dieses Feld ist ein Pflichtfeld.",
-	"slug":             "",
-	"string_matches":   "Bitte denselben Wert wiederholen.",
-	"string_max":       "Geben Sie bitte maximal %d Zeichen ein.",
-	"string_min":       "Geben Sie bitte mindestens %d Zeichen ein.",
-	"time":             "",
-	"unselected_field": "Bitte wählen Sie dieses Feld.",
-	"utf8_letter_num":  "Dieses Feld kann nur Buchstaben und Ziffern enthalten.",
-	"uuid":             "",
-	"weak_password":    "Bitte wählen Sie eine stärkere Passwort.",
-	"web_request_uri":  "Geben Sie bitte eine gültige URI ein.",
+	"account":           "Die eingegebene E-Mail-Adresse oder das Passwort ist falsch.",
+	"alpha_num":         "Dieses Feld kann nur Buchstaben und Ziffern enthalten.",
+	"bic":               "",
+	"bic_iban_mismatch": "",
+	"boolean":           "Dieses Feld muss wahr oder falsch sein.",
+	"breach_unchecked":  "Das Passwort konnte nicht geprüft werden. Bitte versuchen Sie es später erneut.",
+	"captcha":           "Die eingegebenen Zeichen stimmen nicht mit der Sicherheitsabfrage überein. Bitte versuchen Sie es erneut.",
+	"card_brand":        "",
+	"card_expired":      "",
+	"card_expiry":       "",
+	"check_digit":       "",
+	"cidr":              "",
+	"cidr_prefix":       "",
+	"city":              "Wir haben diese Stadt nicht gefunden. Bitte überprüfen Sie die Schreibweise.",
+	"cnpj":              "",
+	"country_code":      "Bitte wählen Sie ein gültiges Land aus.",
+	"cpf":               "",
+	"credit_card":       "Geben Sie bitte eine gültige Kreditkarten-Nummer ein.",
+	"csrf":              "Es gab einen Fehler beim Absenden des Formulars. Bitte versuchen Sie es erneut.",
+	"currency_code":     "",
+	"cvv":               "",
+	"date":              "",
+	"date_future":       "",
+	"date_layout":       "",
+	"date_max":          "",
+	"date_min":          "",
+	"date_past":         "",
+	"date_range_max":    "",
+	"date_range_min":    "",
+	"date_range_order":  "",
+	"date_time":         "",
+	"date_time_layout":  "",
+	"delimiter_min":     "",
+	"delimiter_max":     "",
+	"duplicate":         "Dieses Feld kann keine doppelten Einträge enthalten.",
+	"email":             "Geben Sie bitte eine gültige E-Mail Adresse ein.",
+	"email_deliverable": "Die Domain %s nimmt keine E-Mails an.",
+	"email_disposable":  "",
+	"email_domain":      "E-Mail-Adressen von %s sind nicht erlaubt.",
+	"email_free":        "Bitte verwenden Sie Ihre geschäftliche E-Mail-Adresse, keine von %s.",
+	"email_length":      "",
+	"email_role":        "Bitte verwenden Sie eine persönliche E-Mail-Adresse, keine gemeinsame wie %s@.",
+	"email_taken":       "Dieser Nutzername wird bereits verwendet. Anderen Nutzernamen versuchen?",
+	"email_typo":        "Meinten Sie %s?",
+	"float":             "Dieses Feld muss eine Gleitkommazahl sein. (Beispiel:-10,50)",
+	"float_range":       "Geben Sie bitte einen Wert zwischen %f und %f ein.",
+	"gtin":              "",
+	"host_port":         "",
+	"hostname":          "",
+	"iban":              "",
+	"in_list":           "Bitte treffen Sie eine Auswahl.",
+	"int_range":         "Geben Sie bitte einen Wert zwischen %d und %d ein.",
+	"ip":                "",
+	"ip_public":         "",
+	"isbn":              "",
+	"ismn":              "",
+	"issn":              "",
+	"json":              "",
+	"ksuid":             "",
+	"latitude":          "Breitengrad muss zwischen -90,0 Grad und 90,0 Grad sein.",
+	"longitude":         "Längengrad muss zwischen -180,0 Grad und 180,0 Grad sein.",
+	"mac":               "",
+	"max_age":           "",
+	"min_age":           "",
+	"multiple_entries":  "",
+	"not_in_list":       "Dieses Feld enthält einen ungültigen Eintrag.",
+	"numeric":           "Geben Sie bitte nur Ziffern ein.",
+	"password_breached": "Dieses Passwort ist %d Mal in Datenlecks aufgetaucht. Bitte wählen Sie ein anderes.",
+	"phone":             "",
+	"phone_type":        "",
+	"port":              "",
+	"postal_code":       "",
+	"required":          "Dieses Feld ist ein Pflichtfeld.",
+	"slug":              "",
+	"ssn":               "",
+	"string_matches":    "Bitte denselben Wert wiederholen.",
+	"string_max":        "Geben Sie bitte maximal %d Zeichen ein.",
+	"string_min":        "Geben Sie bitte mindestens %d Zeichen ein.",
+	"tax_id":            "",
+	"time":              "",
+	"time_between":      "",
+	"time_layout":       "",
+	"ulid":              "",
+	"unselected_field":  "Bitte wählen Sie dieses Feld.",
+	"upc":               "",
+	"url":               "",
+	"url_host":          "",
+	"url_length":        "",
+	"url_private":       "",
+	"url_resolve":       "",
+	"utf8_letter_num":   "Dieses Feld kann nur Buchstaben und Ziffern enthalten.",
+	"uuid":              "",
+	"vat_number":        "",
+	"weak_password":     "Bitte wählen Sie eine stärkere Passwort.",
+	"web_request_uri":   "Geben Sie bitte eine gültige URI ein.",
+	"weekday":           "",
 }
 
 var esESErrors = map[string]string{
-	"account":          "La dirección de correo electrónico o la contraseña que Usted ha introducido no son correctas.",
-	"alpha_num":        "Este campo sólo puede contener letras y números.",
-	"boolean":          "Este campo debe ser verdadero o falso.",
-	"captcha":          "Los caracteres escritos no coinciden con la palabra de verificación. Vuelva Usted a intentar.",
-	"city":             "No se encuentra esa ciudad. Por favor verifique Usted su ortografía.",
-	"country_code":     "Seleccione un país válido.",
-	"credit_card":      "Por favor, escriba Usted un número de tarjeta válido.",
-	"csrf":             "Hubo un error al enviar el formulario. Por favor vuelva Usted a intentar.",
-	"currency_code":    "",
-	"date":             "",
-	"date_time":        "",
-	"delimiter_min":    "",
-	"delimiter_max":    "",
-	"duplicate":        "Este campo no puede incluir datos duplicados.",
-	"email":            "Por favor, escriba Usted una dirección de correo válida.",
-	"email_taken":      "Ya existe esa dirección de correo electrónico. ¿Quiere volver a intentarlo Usted?",
-	"float":            "Este campo debe ser un número de punto flotante. (ejemplo:-10,50)",
-	"float_range":      "Por favor, escriba Usted un valor entre %f y %f.",
-	"in_list":          "Por favor haga Usted una selección.",
-	"int_range":        "Por favor, escriba Usted un valor entre %d y %d.",
-	"isbn":             "",
-	"json":             "",
-	"latitude":         "La latitud debe estar entre -90,0 grados y 90,0 grados.",
-	"longitude":        "La longitud debe estar entre -180,0 grados y 180,0 grados.",
-	"multiple_entries": "",
-	"not_in_list":      "Este campo contiene un dato inválido.",
-	"numeric":          "Por favor, escriba Usted sólo dígitos.",
-	"required":         "Este campo es obligatorio.",
-	"slug":             "",
-	"string_matches":   "Por favor, escriba Usted el mismo valor de nuevo.",
-	"string_max":       "Por favor, no escriba Usted más de %d caracteres.",
-	"string_min":       "Por favor, no escriba Usted menos de %d caracteres.",
-	"time":             "",
-	"unselected_field": "Por favor seleccione Usted este campo.",
-	"utf8_letter_num":  "Este campo sólo puede contener letras y números.",
-	"uuid":             "",
-	"weak_password":    "Por favor elija Usted una contraseña mas fuerte.",
-	"web_request_uri":  "Por favor, escriba Usted una URL válida.",
+	"account":           "La dirección de correo electrónico o la contraseña que Usted ha introducido no son correctas.",
+	"alpha_num":         "Este campo sólo puede contener letras y números.",
+	"bic":               "",
+	"bic_iban_mismatch": "",
+	"boolean":           "Este campo debe ser verdadero o falso.",
+	"breach_unchecked":  "",
+	"captcha":           "Los caracteres escritos no coinciden con la palabra de verificación. Vuelva Usted a intentar.",
+	"card_brand":        "",
+	"card_expired":      "",
+	"card_expiry":       "",
+	"check_digit":       "",
+	"cidr":              "",
+	"cidr_prefix":       "",
+	"city":              "No se encuentra esa ciudad. Por favor verifique Usted su ortografía.",
+	"cnpj":              "",
+	"country_code":      "Seleccione un país válido.",
+	"cpf":               "",
+	"credit_card":       "Por favor, escriba Usted un número de tarjeta válido.",
+	"csrf":              "Hubo un error al enviar el formulario. Por favor vuelva Usted a intentar.",
+	"currency_code":     "",
+	"cvv":               "",
+	"date":              "",
+	"date_future":       "",
+	"date_layout":       "",
+	"date_max":          "",
+	"date_min":          "",
+	"date_past":         "",
+	"date_range_max":    "",
+	"date_range_min":    "",
+	"date_range_order":  "",
+	"date_time":         "",
+	"date_time_layout":  "",
+	"delimiter_min":     "",
+	"delimiter_max":     "",
+	"duplicate":         "Este campo no puede incluir datos duplicados.",
+	"email":             "Por favor, escriba Usted una dirección de correo válida.",
+	"email_deliverable": "",
+	"email_disposable":  "",
+	"email_domain":      "",
+	"email_free":        "",
+	"email_length":      "",
+	"email_role":        "",
+	"email_taken":       "Ya existe esa dirección de correo electrónico. ¿Quiere volver a intentarlo Usted?",
+	"email_typo":        "¿Quiso decir %s?",
+	"float":             "Este campo debe ser un número de punto flotante. (ejemplo:-10,50)",
+	"float_range":       "Por favor, escriba Usted un valor entre %f y %f.",
+	"gtin":              "",
+	"host_port":         "",
+	"hostname":          "",
+	"iban":              "",
+	"in_list":           "Por favor haga Usted una selección.",
+	"int_range":         "Por favor, escriba Usted un valor entre %d y %d.",
+	"ip":                "",
+	"ip_public":         "",
+	"isbn":              "",
+	"ismn":              "",
+	"issn":              "",
+	"json":              "",
+	"ksuid":             "",
+	"latitude":          "La latitud debe estar entre -90,0 grados y 90,0 grados.",
+	"longitude":         "La longitud debe estar entre -180,0 grados y 180,0 grados.",
+	"mac":               "",
+	"max_age":           "",
+	"min_age":           "",
+	"multiple_entries":  "",
+	"not_in_list":       "Este campo contiene un dato inválido.",
+	"numeric":           "Por favor, escriba Usted sólo dígitos.",
+	"password_breached": "",
+	"phone":             "",
+	"phone_type":        "",
+	"port":              "",
+	"postal_code":       "",
+	"required":          "Este campo es obligatorio.",
+	"slug":              "",
+	"ssn":               "",
+	"string_matches":    "Por favor, escriba Usted el mismo valor de nuevo.",
+	"string_max":        "Por favor, no escriba Usted más de %d caracteres.",
+	"string_min":        "Por favor, no escriba Usted menos de %d caracteres.",
+	"tax_id":            "",
+	"time":              "",
+	"time_between":      "",
+	"time_layout":       "",
+	"ulid":              "",
+	"unselected_field":  "Por favor seleccione Usted este campo.",
+	"upc":               "",
+	"url":               "",
+	"url_host":          "",
+	"url_length":        "",
+	"url_private":       "",
+	"url_resolve":       "",
+	"utf8_letter_num":   "Este campo sólo puede contener letras y números.",
+	"uuid":              "",
+	"vat_number":        "",
+	"weak_password":     "Por favor elija Usted una contraseña mas fuerte.",
+	"web_request_uri":   "Por favor, escriba Usted una URL válida.",
+	"weekday":           "",
 }
 
 var frFRErrors = map[string]string{
-	"account":          "L'e-mail ou le mot de passe saisi est incorrect.",
-	"alpha_num":        "Ce champ ne peut contenir que des lettres et des chiffres.",
-	"boolean":          "Ce champ doit être vrai ou faux.",
-	"captcha":          "Les caractères que vous avez saisis ne correspondent pas à l'image de vérification des mots. Veuillez réessayer.",
-	"city":             "Nous n'avons pas trouvé cette ville. Veuillez vérifier votre orthographe.",
-	"country_code":     "Sélectionnez un pays valide.",
-	"credit_card":      "Veuillez fournir un numéro de carte de crédit valide.",
-	"csrf":             "Une erreur s'est produite lors de la soumission du formulaire. Veuillez réessayer.",
-	"currency_code":    "",
-	"date":             "",
-	"date_time":        "",
-	"delimiter_min":    "",
-	"delimiter_max":    "",
-	"duplicate":        "Ce champ ne peut pas contenir les éléments en double.",
-	"email":            "Veuillez fournir une adresse électronique valide.",
-	"email_taken":      "Ce nom d'utilisateur est déjà attribué. Voulez-vous en essayer un autre ?",
-	"float":            "Ce champ doit être un nombre à virgule flottante. (exemple:-10,50)",
-	"float_range":      "Veuillez fournir une valeur entre %f et %f.",
-	"in_list":          "Veuillez faire une sélection.",
-	"int_range":        "Veuillez fournir une valeur entre %d et %d.",
-	"isbn":             "",
-	"json":             "",
-	"latitude":         "La latitude doit être comprise entre -90,0 degrés et 90,0 degrés.",
-	"longitude":        "La longitude doit être comprise entre -180,0 degrés et 180,0 degrés.",
-	"multiple_entries": "",
-	"not_in_list":      "Ce champ contient une entrée non valide.",
-	"numeric":          "Veuillez fournir seulement des chiffres.",
-	"required":         "Ce champ est obligatoire.",
-	"slug":             "",
-	"string_matches":   "Veuillez fournir encore la même valeur.",
-	"string_max":       "Veuillez fournir au plus %d caractères.",
-	"string_min":       "Veuillez fournir au moins %d caractères.",
-	"time":             "",
-	"unselected_field": "Veuillez sélectionner ce champ.",
-	"utf8_letter_num":  "Ce champ ne peut contenir que des lettres et des chiffres.",
-	"uuid":             "",
-	"weak_password":    "Veuillez choisir un mot de passe plus fort.",
-	"web_request_uri":  "Veuillez fournir une adresse URL valide.",
+	"account":           "L'e-mail ou le mot de passe saisi est incorrect.",
+	"alpha_num":         "Ce champ ne peut contenir que des lettres et des chiffres.",
+	"bic":               "",
+	"bic_iban_mismatch": "",
+	"boolean":           "Ce champ doit être vrai ou faux.",
+	"breach_unchecked":  "",
+	"captcha":           "Les caractères que vous avez saisis ne correspondent pas à l'image de vérification des mots. Veuillez réessayer.",
+	"card_brand":        "",
+	"card_expired":      "",
+	"card_expiry":       "",
+	"check_digit":       "",
+	"cidr":              "",
+	"cidr_prefix":       "",
+	"city":              "Nous n'avons pas trouvé cette ville. Veuillez vérifier votre orthographe.",
+	"cnpj":              "",
+	"country_code":      "Sélectionnez un pays valide.",
+	"cpf":               "",
+	"credit_card":       "Veuillez fournir un numéro de carte de crédit valide.",
+	"csrf":              "Une erreur s'est produite lors de la soumission du formulaire. Veuillez réessayer.",
+	"currency_code":     "",
+	"cvv":               "",
+	"date":              "",
+	"date_future":       "",
+	"date_layout":       "",
+	"date_max":          "",
+	"date_min":          "",
+	"date_past":         "",
+	"date_range_max":    "",
+	"date_range_min":    "",
+	"date_range_order":  "",
+	"date_time":         "",
+	"date_time_layout":  "",
+	"delimiter_min":     "",
+	"delimiter_max":     "",
+	"duplicate":         "Ce champ ne peut pas contenir les éléments en double.",
+	"email":             "Veuillez fournir une adresse électronique valide.",
+	"email_deliverable": "",
+	"email_disposable":  "",
+	"email_domain":      "",
+	"email_free":        "",
+	"email_length":      "",
+	"email_role":        "",
+	"email_taken":       "Ce nom d'utilisateur est déjà attribué. Voulez-vous en essayer un autre ?",
+	"email_typo":        "Vouliez-vous dire %s ?",
+	"float":             "Ce champ doit être un nombre à virgule flottante. (exemple:-10,50)",
+	"float_range":       "Veuillez fournir une valeur entre %f et %f.",
+	"gtin":              "",
+	"host_port":         "",
+	"hostname":          "",
+	"iban":              "",
+	"in_list":           "Veuillez faire une sélection.",
+	"int_range":         "Veuillez fournir une valeur entre %d et %d.",
+	"ip":                "",
+	"ip_public":         "",
+	"isbn":              "",
+	"ismn":              "",
+	"issn":              "",
+	"json":              "",
+	"ksuid":             "",
+	"latitude":          "La latitude doit être comprise entre -90,0 degrés et 90,0 degrés.",
+	"longitude":         "La longitude doit être comprise entre -180,0 degrés et 180,0 degrés.",
+	"mac":               "",
+	"max_age":           "",
+	"min_age":           "",
+	"multiple_entries":  "",
+	"not_in_list":       "Ce champ contient une entrée non valide.",
+	"numeric":           "Veuillez fournir seulement des chiffres.",
+	"password_breached": "",
+	"phone":             "",
+	"phone_type":        "",
+	"port":              "",
+	"postal_code":       "",
+	"required":          "Ce champ est obligatoire.",
+	"slug":              "",
+	"ssn":               "",
+	"string_matches":    "Veuillez fournir encore la même valeur.",
+	"string_max":        "Veuillez fournir au plus %d caractères.",
+	"string_min":        "Veuillez fournir au moins %d caractères.",
+	"tax_id":            "",
+	"time":              "",
+	"time_between":      "",
+	"time_layout":       "",
+	"ulid":              "",
+	"unselected_field":  "Veuillez sélectionner ce champ.",
+	"upc":               "",
+	"url":               "",
+	"url_host":          "",
+	"url_length":        "",
+	"url_private":       "",
+	"url_resolve":       "",
+	"utf8_letter_num":   "Ce champ ne peut contenir que des lettres et des chiffres.",
+	"uuid":              "",
+	"vat_number":        "",
+	"weak_password":     "Veuillez choisir un mot de passe plus fort.",
+	"web_request_uri":   "Veuillez fournir une adresse URL valide.",
+	"weekday":           "",
 }
 
 var itITErrors = map[string]string{
-	"account":          "La password o il nome utente inserito non è corretto.",
-	"alpha_num":        "Questo campo può contenere solo lettere e numeri.",
-	"boolean":          "Questo campo deve essere true o false.",
-	"captcha":          "I caratteri immessi non corrispondono a quelli della parola da noi verificata. Riprova.",
-	"city":             "Non abbiamo trovato quella città. Si prega di controllare l'ortografia.",
-	"country_code":     "Selezionare un paese valido.",
-	"credit_card":      "Inserisci un numero di carta di credito valido.",
-	"csrf":             "Si è verificato un errore durante l'invio del modulo. Si prega di riprovare.",
-	"currency_code":    "",
-	"date":             "",
-	"date_time":        "",
-	"delimiter_min":    "",
-	"delimiter_max":    "",
-	"duplicate":        "Questo campo non può contenere le voci duplicate.",
-	"email":            "Inserisci un indirizzo email valido.",
-	"email_taken":      "Nome utente già in uso. Vuoi provarne un altro?",
-	"float":            "Questo campo deve essere un numero a virgola mobile. (esempio:-10,50)",
-	"float_range":      "Inserisci un valore compreso tra %f e %f.",
-	"in_list":          "Si prega di effettuare una selezione.",
-	"int_range":        "Inserisci un valore compreso tra %d e %d.",
-	"isbn":             "",
-	"json":             "",
-	"latitude":         "La latitudine deve essere compresa tra -90,0 gradi e 90,0 gradi.",
-	"longitude":        "La longitudine deve essere compresa tra -180,0 gradi e 180,0 gradi.",
-	"multiple_entries": "",
-	"not_in_list":      "Questo campo contiene una voce non valida.",
-	"numeric":          "Inserisci solo numeri.",
-	"required":         "Campo obbligatorio.",
-	"slug":             "",
-	"string_matches":   "Il valore non corrisponde.",
-	"string_max":       "Non inserire più di %d caratteri.",
-	"string_min":       "Inserisci almeno %d caratteri.",
-	"time":             "",
-	"unselected_field": "Si prega di selezionare questo campo.",
-	"utf8_letter_num":  "Questo campo può contenere solo lettere e numeri.",
-	"uuid":             "",
-	"weak_password":    "Si prega di scegliere una password più forte.",
-	"web_request_uri":  "Inserisci un indirizzo web valido.",
+	"account":           "La password o il nome utente inserito non è corretto.",
+	"alpha_num":         "Questo campo può contenere solo lettere e numeri.",
+	"bic":               "",
+	"bic_iban_mismatch": "",
+	"boolean":           "Questo campo deve essere true o false.",
+	"breach_unchecked":  "",
+	"captcha":           "I caratteri immessi non corrispondono a quelli della parola da noi verificata. Riprova.",
+	"card_brand":        "",
+	"card_expired":      "",
+	"card_expiry":       "",
+	"check_digit":       "",
+	"cidr":              "",
+	"cidr_prefix":       "",
+	"city":              "Non abbiamo trovato quella città. Si prega di controllare l'ortografia.",
+	"cnpj":              "",
+	"country_code":      "Selezionare un paese valido.",
+	"cpf":               "",
+	"credit_card":       "Inserisci un numero di carta di credito valido.",
+	"csrf":              "Si è verificato un errore durante l'invio del modulo. Si prega di riprovare.",
+	"currency_code":     "",
+	"cvv":               "",
+	"date":              "",
+	"date_future":       "",
+	"date_layout":       "",
+	"date_max":          "",
+	"date_min":          "",
+	"date_past":         "",
+	"date_range_max":    "",
+	"date_range_min":    "",
+	"date_range_order":  "",
+	"date_time":         "",
+	"date_time_layout":  "",
+	"delimiter_min":     "",
+	"delimiter_max":     "",
+	"duplicate":         "Questo campo non può contenere le voci duplicate.",
+	"email":             "Inserisci un indirizzo email valido.",
+	"email_deliverable": "",
+	"email_disposable":  "",
+	"email_domain":      "",
+	"email_free":        "",
+	"email_length":      "",
+	"email_role":        "",
+	"email_taken":       "Nome utente già in uso. Vuoi provarne un altro?",
+	"email_typo":        "Intendevi %s?",
+	"float":             "Questo campo deve essere un numero a virgola mobile. (esempio:-10,50)",
+	"float_range":       "Inserisci un valore compreso tra %f e %f.",
+	"gtin":              "",
+	"host_port":         "",
+	"hostname":          "",
+	"iban":              "",
+	"in_list":           "Si prega di effettuare una selezione.",
+	"int_range":         "Inserisci un valore compreso tra %d e %d.",
+	"ip":                "",
+	"ip_public":         "",
+	"isbn":              "",
+	"ismn":              "",
+	"issn":              "",
+	"json":              "",
+	"ksuid":             "",
+	"latitude":          "La latitudine deve essere compresa tra -90,0 gradi e 90,0 gradi.",
+	"longitude":         "La longitudine deve essere compresa tra -180,0 gradi e 180,0 gradi.",
+	"mac":               "",
+	"max_age":           "",
+	"min_age":           "",
+	"multiple_entries":  "",
+	"not_in_list":       "Questo campo contiene una voce non valida.",
+	"numeric":           "Inserisci solo numeri.",
+	"password_breached": "",
+	"phone":             "",
+	"phone_type":        "",
+	"port":              "",
+	"postal_code":       "",
+	"required":          "Campo obbligatorio.",
+	"slug":              "",
+	"ssn":               "",
+	"string_matches":    "Il valore non corrisponde.",
+	"string_max":        "Non inserire più di %d caratteri.",
+	"string_min":        "Inserisci almeno %d caratteri.",
+	"tax_id":            "",
+	"time":              "",
+	"time_between":      "",
+	"time_layout":       "",
+	"ulid":              "",
+	"unselected_field":  "Si prega di selezionare questo campo.",
+	"upc":               "",
+	"url":               "",
+	"url_host":          "",
+	"url_length":        "",
+	"url_private":       "",
+	"url_resolve":       "",
+	"utf8_letter_num":   "Questo campo può contenere solo lettere e numeri.",
+	"uuid":              "",
+	"vat_number":        "",
+	"weak_password":     "Si prega di scegliere una password più forte.",
+	"web_request_uri":   "Inserisci un indirizzo web valido.",
+	"weekday":           "",
 }
 
 var ptBRErrors = map[string]string{
-	"account":          "O e-mail ou a senha inseridos estão incorretos.",
-	"alpha_num":        "Este campo só pode conter letras e números.",
-	"boolean":          "Este campo deve ser verdadeiro ou falso.",
-	"captcha":          "Os caracteres inseridos não correspondem à verificação de palavras. Tente novamente.",
-	"city":             "Não encontramos essa cidade. Por favor verifique a ortografia.",
-	"country_code":     "Por favor, selecione um país válido.",
-	"credit_card":      "Por favor, forneça um cartão de crédito válido.",
-	"csrf":             "Houve um erro ao enviar o formulário. Por favor, tente novamente.",
-	"currency_code":    "",
-	"date":             "",
-	"date_time":        "",
-	"delimiter_min":    "",
-	"delimiter_max":    "",
-	"duplicate":        "Este campo não pode conter elementos duplicados.",
-	"email":            "Por favor, forneça um endereço de email válido.",
-	"email_taken":      "Alguém já escolheu esse e-mail. Tente outro.",
-	"float":            "Este campo deve ser um número de ponto flutuante. (exemplo:-10,50)",
-	"float_range":      "Por favor, forneça um valor entre %f e %f.",
-	"in_list":          "Por favor, faça uma seleção.",
-	"int_range":        "Por favor, forneça um valor entre %d e %d.",
-	"isbn":             "",
-	"json":             "",
-	"latitude":         "O Latitude deve estar entre -90,0 graus e 90,0 graus.",
-	"longitude":        "A longitude deve estar entre -180,0 graus e 180,0 graus.",
-	"multiple_entries": "",
-	"not_in_list":      "Este campo contém uma entrada inválida.",
-	"numeric":          "Por favor, forneça somente dígitos.",
-	"required":         "Este campo é requerido.",
-	"slug":             "",
-	"string_matches":   "Por favor, forneça o mesmo valor novamente.",
-	"string_max":       "Por favor, forneça não mais que %d caracteres.",
-	"string_min":       "Por favor, forneça ao menos %d caracteres.",
-	"time":             "",
-	"unselected_field": "Por favor, seleccione este campo.",
-	"utf8_letter_num":  "Este campo só pode conter letras e números.",
-	"uuid":             "",
-	"weak_password":    "Por favor escolha uma senha mais forte.",
-	"web_request_uri":  "Por favor, forneça uma URL válida.",
+	"account":           "O e-mail ou a senha inseridos estão incorretos.",
+	"alpha_num":         "Este campo só pode conter letras e números.",
+	"bic":               "",
+	"bic_iban_mismatch": "",
+	"boolean":           "Este campo deve ser verdadeiro ou falso.",
+	"breach_unchecked":  "Não foi possível verificar a senha. Tente novamente mais tarde.",
+	"captcha":           "Os caracteres inseridos não correspondem à verificação de palavras. Tente novamente.",
+	"card_brand":        "",
+	"card_expired":      "",
+	"card_expiry":       "",
+	"check_digit":       "",
+	"cidr":              "",
+	"cidr_prefix":       "",
+	"city":              "Não encontramos essa cidade. Por favor verifique a ortografia.",
+	"cnpj":              "Por favor, forneça um CNPJ válido.",
+	"country_code":      "Por favor, selecione um país válido.",
+	"cpf":               "Por favor, forneça um CPF válido.",
+	"credit_card":       "Por favor, forneça um cartão de crédito válido.",
+	"csrf":              "Houve um erro ao enviar o formulário. Por favor, tente novamente.",
+	"currency_code":     "",
+	"cvv":               "",
+	"date":              "",
+	"date_future":       "",
+	"date_layout":       "",
+	"date_max":          "",
+	"date_min":          "",
+	"date_past":         "",
+	"date_range_max":    "",
+	"date_range_min":    "",
+	"date_range_order":  "",
+	"date_time":         "",
+	"date_time_layout":  "",
+	"delimiter_min":     "",
+	"delimiter_max":     "",
+	"duplicate":         "Este campo não pode conter elementos duplicados.",
+	"email":             "Por favor, forneça um endereço de email válido.",
+	"email_deliverable": "O domínio %s não aceita e-mails.",
+	"email_disposable":  "",
+	"email_domain":      "Endereços de e-mail de %s não são permitidos.",
+	"email_free":        "Use o seu e-mail corporativo, não um de %s.",
+	"email_length":      "",
+	"email_role":        "Use um e-mail pessoal, não um compartilhado como %s@.",
+	"email_taken":       "Alguém já escolheu esse e-mail. Tente outro.",
+	"email_typo":        "Você quis dizer %s?",
+	"float":             "Este campo deve ser um número de ponto flutuante. (exemplo:-10,50)",
+	"float_range":       "Por favor, forneça um valor entre %f e %f.",
+	"gtin":              "",
+	"host_port":         "",
+	"hostname":          "",
+	"iban":              "",
+	"in_list":           "Por favor, faça uma seleção.",
+	"int_range":         "Por favor, forneça um valor entre %d e %d.",
+	"ip":                "",
+	"ip_public":         "",
+	"isbn":              "",
+	"ismn":              "",
+	"issn":              "",
+	"json":              "",
+	"ksuid":             "",
+	"latitude":          "O Latitude deve estar entre -90,0 graus e 90,0 graus.",
+	"longitude":         "A longitude deve estar entre -180,0 graus e 180,0 graus.",
+	"mac":               "",
+	"max_age":           "",
+	"min_age":           "",
+	"multiple_entries":  "",
+	"not_in_list":       "Este campo contém uma entrada inválida.",
+	"numeric":           "Por favor, forneça somente dígitos.",
+	"password_breached": "Esta senha apareceu %d vezes em vazamentos de dados. Escolha outra.",
+	"phone":             "",
+	"phone_type":        "",
+	"port":              "",
+	"postal_code":       "",
+	"required":          "Este campo é requerido.",
+	"slug":              "",
+	"ssn":               "",
+	"string_matches":    "Por favor, forneça o mesmo valor novamente.",
+	"string_max":        "Por favor, forneça não mais que %d caracteres.",
+	"string_min":        "Por favor, forneça ao menos %d caracteres.",
+	"tax_id":            "",
+	"time":              "",
+	"time_between":      "",
+	"time_layout":       "",
+	"ulid":              "",
+	"unselected_field":  "Por favor, seleccione este campo.",
+	"upc":               "",
+	"url":               "",
+	"url_host":          "",
+	"url_length":        "",
+	"url_private":       "",
+	"url_resolve":       "",
+	"utf8_letter_num":   "Este campo só pode conter letras e números.",
+	"uuid":              "",
+	"vat_number":        "",
+	"weak_password":     "Por favor escolha uma senha mais forte.",
+	"web_request_uri":   "Por favor, forneça uma URL válida.",
+	"weekday":           "",
 }
 
 var blankErrors = map[string]string{
-	"account":          "",
-	"alpha_num":        "",
-	"boolean":          "",
-	"captcha":          "",
-	"city":             "",
-	"country_code":     "",
-	"credit_card":      "",
-	"csrf":             "",
-	"currency_code":    "",
-	"date":             "",
-	"date_time":        "",
-	"delimiter_min":    "",
-	"delimiter_max":    "",
-	"duplicate":        "",
-	"email":            "",
-	"email_taken":      "",
-	"float":            "",
-	"float_range":      "",
-	"in_list":          "",
-	"int_range":        "",
-	"isbn":             "",
-	"json":             "",
-	"latitude":         "",
-	"longitude":        "",
-	"multiple_entries": "",
-	"not_in_list":      "",
-	"numeric":          "",
-	"required":         "",
-	"slug":             "",
-	"string_matches":   "",
-	"string_max":       "",
-	"string_min":       "",
-	"time":             "",
-	"unselected_field": "",
-	"utf8_letter_num":  "",
-	"uuid":             "",
-	"weak_password":    "",
-	"web_request_uri":  "",
+	"account":           "",
+	"alpha_num":         "",
+	"bic":               "",
+	"bic_iban_mismatch": "",
+	"boolean":           "",
+	"breach_unchecked":  "",
+	"captcha":           "",
+	"card_brand":        "",
+	"card_expired":      "",
+	"card_expiry":       "",
+	"check_digit":       "",
+	"cidr":              "",
+	"cidr_prefix":       "",
+	"city":              "",
+	"cnpj":              "",
+	"country_code":      "",
+	"cpf":               "",
+	"credit_card":       "",
+	"csrf":              "",
+	"currency_code":     "",
+	"cvv":               "",
+	"date":              "",
+	"date_future":       "",
+	"date_layout":       "",
+	"date_max":          "",
+	"date_min":          "",
+	"date_past":         "",
+	"date_range_max":    "",
+	"date_range_min":    "",
+	"date_range_order":  "",
+	"date_time":         "",
+	"date_time_layout":  "",
+	"delimiter_min":     "",
+	"delimiter_max":     "",
+	"duplicate":         "",
+	"email":             "",
+	"email_deliverable": "",
+	"email_disposable":  "",
+	"email_domain":      "",
+	"email_free":        "",
+	"email_length":      "",
+	"email_role":        "",
+	"email_taken":       "",
+	"email_typo":        "",
+	"float":             "",
+	"float_range":       "",
+	"gtin":              "",
+	"host_port":         "",
+	"hostname":          "",
+	"iban":              "",
+	"in_list":           "",
+	"int_range":         "",
+	"ip":                "",
+	"ip_public":         "",
+	"isbn":              "",
+	"ismn":              "",
+	"issn":              "",
+	"json":              "",
+	"ksuid":             "",
+	"latitude":          "",
+	"longitude":         "",
+	"mac":               "",
+	"max_age":           "",
+	"min_age":           "",
+	"multiple_entries":  "",
+	"not_in_list":       "",
+	"numeric":           "",
+	"password_breached": "",
+	"phone":             "",
+	"phone_type":        "",
+	"port":              "",
+	"postal_code":       "",
+	"required":          "",
+	"slug":              "",
+	"ssn":               "",
+	"string_matches":    "",
+	"string_max":        "",
+	"string_min":        "",
+	"tax_id":            "",
+	"time":              "",
+	"time_between":      "",
+	"time_layout":       "",
+	"ulid":              "",
+	"unselected_field":  "",
+	"upc":               "",
+	"url":               "",
+	"url_host":          "",
+	"url_length":        "",
+	"url_private":       "",
+	"url_resolve":       "",
+	"utf8_letter_num":   "",
+	"uuid":              "",
+	"vat_number":        "",
+	"weak_password":     "",
+	"web_request_uri":   "",
+	"weekday":           "",
 }
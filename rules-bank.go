@@ -0,0 +1,278 @@
+package formvalidator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/dholtzmann/formvalidator/checkdigit"
+)
+
+/*
+	BBAN (basic bank account number) structure of each country in the IBAN registry, keyed by lowercase ISO 3166 codes
+	Format: length and type of each part, n = digits, a = uppercase letters, c = letters and digits. ("8n10n" for Germany)
+	The length of an IBAN is the BBAN plus 4 (country code and check digits).
+*/
+var ibanFormats = map[string]string{
+	"ad": "4n4n12c",
+	"ae": "3n16n",
+	"al": "8n16c",
+	"at": "5n11n",
+	"az": "4a20c",
+	"ba": "3n3n8n2n",
+	"be": "3n7n2n",
+	"bg": "4a4n2n8c",
+	"bh": "4a14c",
+	"br": "8n5n10n1a1c",
+	"by": "4c4n16c",
+	"ch": "5n12c",
+	"cr": "4n14n",
+	"cy": "3n5n16c",
+	"cz": "4n6n10n",
+	"de": "8n10n",
+	"dk": "4n9n1n",
+	"do": "4c20n",
+	"ee": "2n2n11n1n",
+	"eg": "4n4n17n",
+	"es": "4n4n1n1n10n",
+	"fi": "3n11n",
+	"fo": "4n9n1n",
+	"fr": "5n5n11c2n",
+	"gb": "4a6n8n",
+	"ge": "2a16n",
+	"gi": "4a15c",
+	"gl": "4n9n1n",
+	"gr": "3n4n16c",
+	"gt": "4c20c",
+	"hr": "7n10n",
+	"hu": "3n4n1n15n1n",
+	"ie": "4a6n8n",
+	"il": "3n3n13n",
+	"iq": "4a3n12n",
+	"is": "4n2n6n10n",
+	"it": "1a5n5n12c",
+	"jo": "4a4n18c",
+	"kw": "4a22c",
+	"kz": "3n13c",
+	"lb": "4n20c",
+	"lc": "4a24c",
+	"li": "5n12c",
+	"lt": "5n11n",
+	"lu": "3n13c",
+	"lv": "4a13c",
+	"mc": "5n5n11c2n",
+	"md": "2c18c",
+	"me": "3n13n2n",
+	"mk": "3n10c2n",
+	"mr": "5n5n11n2n",
+	"mt": "4a5n18c",
+	"mu": "4a2n2n12n3n3a",
+	"nl": "4a10n",
+	"no": "4n6n1n",
+	"pk": "4a16c",
+	"pl": "8n16n",
+	"ps": "4a21c",
+	"pt": "4n4n11n2n",
+	"qa": "4a21c",
+	"ro": "4a16c",
+	"rs": "3n13n2n",
+	"sa": "2n18c",
+	"sc": "4a2n2n16n3a",
+	"se": "3n16n1n",
+	"si": "5n8n2n",
+	"sk": "4n6n10n",
+	"sm": "1a5n5n12c",
+	"st": "4n4n11n2n",
+	"sv": "4a20n",
+	"tl": "3n14n2n",
+	"tn": "2n3n13n2n",
+	"tr": "5n1n16c",
+	"ua": "6n19c",
+	"va": "3n15n",
+	"vg": "4a16n",
+	"xk": "4n10n2n",
+}
+
+// IBAN in its electronic format: no spaces, uppercase
+func normalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Replace(strings.TrimSpace(iban), " ", "", -1))
+}
+
+/*
+	Test the country, the length and structure of the BBAN, and the ISO 7064 mod 97-10 checksum of an IBAN (electronic format)
+*/
+func isValidIBAN(iban string) bool {
+	if len(iban) < 5 || !isDigits(iban[2:4]) {
+		return false
+	}
+
+	format, ok := ibanFormats[strings.ToLower(iban[:2])]
+	if !ok || !matchesBBAN(iban[4:], format) {
+		return false
+	}
+
+	// move the country code and check digits to the end, letters are numbers: A = 10, B = 11, ...
+	return checkdigit.Mod97_10.Verify(iban[4:] + iban[:4])
+}
+
+// "8n10n" -> 8 digits followed by 10 digits
+func matchesBBAN(bban, format string) bool {
+	pos := 0
+	for len(format) > 0 {
+		n := strings.IndexAny(format, "anc")
+		count, _ := strconv.Atoi(format[:n])
+		if pos+count > len(bban) {
+			return false
+		}
+
+		for _, c := range bban[pos : pos+count] {
+			isDigit := c >= '0' && c <= '9'
+			isLetter := c >= 'A' && c <= 'Z'
+			if (format[n] == 'n' && !isDigit) || (format[n] == 'a' && !isLetter) || (format[n] == 'c' && !isDigit && !isLetter) {
+				return false
+			}
+		}
+
+		pos += count
+		format = format[n+1:]
+	}
+
+	return pos == len(bban)
+}
+
+/*
+	BIC/SWIFT code, 8 or 11 characters: bank (4 letters), ISO 3166 country (2 letters), location (2), optional branch (3)
+*/
+func isValidBIC(bic string) bool {
+	if len(bic) != 8 && len(bic) != 11 {
+		return false
+	}
+
+	for n, c := range bic {
+		isLetter := c >= 'A' && c <= 'Z'
+		if n < 6 && !isLetter {
+			return false
+		}
+		if !isLetter && (c < '0' || c > '9') {
+			return false
+		}
+	}
+
+	return CountryCodes.Contains(strings.ToLower(bic[4:6]))
+}
+
+// BIC countries that use the IBAN of another country (territories, crown dependencies)
+var bicIBANCountries = map[string]string{
+	"ax": "fi",
+	"bl": "fr", "gf": "fr", "gp": "fr", "mf": "fr", "mq": "fr", "nc": "fr", "pf": "fr", "pm": "fr", "re": "fr", "tf": "fr", "wf": "fr", "yt": "fr",
+	"gg": "gb", "im": "gb", "je": "gb",
+}
+
+// -----------------------
+
+type iban struct {
+}
+
+func IBAN() Rule {
+	return &iban{}
+}
+
+/*
+	International bank account number, spaces are allowed: "DE89 3704 0044 0532 0130 00"
+	The entry is normalized to the electronic format "DE89370400440532013000", see Normalizer
+	return error - FormError with message and extra data if revelant
+*/
+func (i *iban) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if isValidIBAN(normalizeIBAN(field)) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["iban"]), nil
+}
+
+func (i *iban) Normalize(field string) string {
+	return normalizeIBAN(field)
+}
+
+// -----------------------
+
+type bic struct {
+}
+
+func BIC() Rule {
+	return &bic{}
+}
+
+/*
+	BIC/SWIFT code of a bank, "DEUTDEFF" or "DEUTDEFF500", lowercase is allowed and normalized to uppercase
+	return error - FormError with message and extra data if revelant
+*/
+func (b *bic) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if isValidBIC(b.Normalize(field)) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["bic"]), nil
+}
+
+func (b *bic) Normalize(field string) string {
+	return normalizeIBAN(field)
+}
+
+// -----------------------
+
+type bicMatchesIBAN struct {
+	iban string
+}
+
+/*
+	For the BIC field, does the BIC belong to the country of the IBAN from another field? BICMatchesIBAN(form.Get("IBAN"))
+	Invalid or blank IBANs are skipped, use IBAN() on that field.
+*/
+func BICMatchesIBAN(iban string) Rule {
+	return &bicMatchesIBAN{normalizeIBAN(iban)}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (b *bicMatchesIBAN) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := normalizeIBAN(getFirstKey(fields))
+
+	// if blank, it is fine
+	if len(field) == 0 || !isValidIBAN(b.iban) {
+		return nil, nil
+	}
+
+	if !isValidBIC(field) {
+		return errors.New(errorMessages["bic"]), nil
+	}
+
+	country := strings.ToLower(field[4:6])
+	if c, ok := bicIBANCountries[country]; ok && c == strings.ToLower(b.iban[:2]) {
+		return nil, nil
+	}
+
+	if country == strings.ToLower(b.iban[:2]) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["bic_iban_mismatch"]), nil
+}
@@ -0,0 +1,111 @@
+package formvalidator
+
+import (
+	"testing"
+)
+
+func Test_iban(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+		normalized  string
+	}{
+		{"", true, ""},
+		{"DE89 3704 0044 0532 0130 00", true, "DE89370400440532013000"},
+		{"de89370400440532013000", true, "DE89370400440532013000"},
+		{"GB82 WEST 1234 5698 7654 32", true, "GB82WEST12345698765432"},
+		{"FR14 2004 1010 0505 0001 3M02 606", true, "FR1420041010050500013M02606"},
+		{"NL91ABNA0417164300", true, "NL91ABNA0417164300"},
+		{"BE68 5390 0754 7034", true, "BE68539007547034"},
+		{"CH93 0076 2011 6238 5295 7", true, "CH9300762011623852957"},
+		{"IT60 X054 2811 1010 0000 0123 456", true, "IT60X0542811101000000123456"},
+		{"NO93 8601 1117 947", true, "NO9386011117947"},
+		{"BR1800360305000010009795493C1", true, "BR1800360305000010009795493C1"},
+		{"MT84MALT011000012345MTLCAST001S", true, "MT84MALT011000012345MTLCAST001S"},
+		{"XK051212012345678906", true, "XK051212012345678906"},
+		{"DE88 3704 0044 0532 0130 00", false, ""},   // checksum
+		{"DE89 3704 0044 0532 0130 0", false, ""},    // length
+		{"DE89 3704 0044 0532 0130 000", false, ""},  // length
+		{"GB82 1234 1234 5698 7654 32", false, ""},   // bank code must be letters
+		{"US64 SVBK US6S 3300 9673 8637", false, ""}, // no IBAN in the US
+		{"DE89-3704-0044-0532-0130-00", false, ""},
+		{"DEXX 3704 0044 0532 0130 00", false, ""},
+		{"DE", false, ""},
+		{"ÄE89 3704 0044 0532 0130 00", false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IBAN()
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("iban(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("iban(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_bic(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+	}{
+		{"", true},
+		{"DEUTDEFF", true},
+		{"DEUTDEFF500", true},
+		{"deutdeff", true},
+		{"NEDSZAJJXXX", true},
+		{"DEUTXXFF", false}, // unknown country
+		{"DEUTDEF", false},
+		{"DEUTDEFF50", false},
+		{"DEU1DEFF", false},
+		{"DEUTDEFF-00", false},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = BIC()
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("bic(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+	}
+}
+
+func Test_bicMatchesIBAN(t *testing.T) {
+	var list = []struct {
+		field       string
+		iban        string
+		expectation string
+	}{
+		{"", "DE89 3704 0044 0532 0130 00", ""},
+		{"DEUTDEFF", "DE89 3704 0044 0532 0130 00", ""},
+		{"DEUTDEFF", "", ""},
+		{"DEUTDEFF", "DE00 0000", ""}, // invalid IBAN, see IBAN()
+		{"BNPAFRPP", "DE89 3704 0044 0532 0130 00", "bic_iban_mismatch"},
+		{"BNPAFRPP", "FR14 2004 1010 0505 0001 3M02 606", ""},
+		{"BNPARERX", "FR14 2004 1010 0505 0001 3M02 606", ""}, // Réunion uses French IBANs
+		{"BNPARERX", "DE89 3704 0044 0532 0130 00", "bic_iban_mismatch"},
+		{"DEUTDE", "DE89 3704 0044 0532 0130 00", "bic"},
+	}
+
+	for _, l := range list {
+		err, _ := BICMatchesIBAN(l.iban).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("bicMatchesIBAN(%s, %s): Result[%s]. Expected: %s", l.field, l.iban, result, l.expectation)
+		}
+	}
+}
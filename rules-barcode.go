@@ -0,0 +1,301 @@
+package formvalidator
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dholtzmann/formvalidator/checkdigit"
+)
+
+var ErrInvalidISBN = errors.New("String is not a valid ISBN!")
+var ErrInvalidUPC = errors.New("String is not a valid UPC-E!")
+
+/*
+	Convert an ISBN-10 to ISBN-13, "0-306-40615-2" -> "9780306406157"
+	Spaces and hyphens are allowed, the check digit is verified
+*/
+func ISBN10To13(isbn string) (string, error) {
+	isbn = strings.ToUpper(separatorReplacer.Replace(strings.TrimSpace(isbn)))
+	if len(isbn) != 10 || !isDigits(isbn[:9]) || !checkdigit.Mod11.Verify(isbn) {
+		return "", ErrInvalidISBN
+	}
+
+	check, _ := checkdigit.GS1.Generate("978" + isbn[:9])
+	return "978" + isbn[:9] + check, nil
+}
+
+/*
+	Convert an ISBN-13 to ISBN-10, "978-0-306-40615-7" -> "0306406152"
+	Only ISBNs starting with 978 have an ISBN-10, 979 ones return ErrInvalidISBN
+*/
+func ISBN13To10(isbn string) (string, error) {
+	isbn = separatorReplacer.Replace(strings.TrimSpace(isbn))
+	if len(isbn) != 13 || !strings.HasPrefix(isbn, "978") || !checkdigit.GS1.Verify(isbn) {
+		return "", ErrInvalidISBN
+	}
+
+	check, _ := checkdigit.Mod11.Generate(isbn[3:12])
+	return isbn[3:12] + check, nil
+}
+
+/*
+	Expand a UPC-E to the UPC-A it stands for, "01234565" -> "012345000065"
+	UPC-E has 8 digits: number system 0 or 1, 6 digits and the check digit of the UPC-A
+*/
+func ExpandUPCE(upc string) (string, error) {
+	upc = separatorReplacer.Replace(strings.TrimSpace(upc))
+	if len(upc) != 8 || !isDigits(upc) || upc[0] > '1' {
+		return "", ErrInvalidUPC
+	}
+
+	// the last of the 6 digits says where the zeros of the manufacturer and product codes were left out
+	d := upc[1:7]
+	var expanded string
+	switch d[5] {
+	case '0', '1', '2':
+		expanded = d[:2] + d[5:] + "0000" + d[2:5]
+	case '3':
+		expanded = d[:3] + "00000" + d[3:5]
+	case '4':
+		expanded = d[:4] + "00000" + d[4:5]
+	default:
+		expanded = d[:5] + "0000" + d[5:]
+	}
+
+	expanded = upc[:1] + expanded + upc[7:]
+	if !checkdigit.GS1.Verify(expanded) {
+		return "", ErrInvalidUPC
+	}
+	return expanded, nil
+}
+
+// -----------------------
+
+type gtin struct {
+	lengths []int
+}
+
+/*
+	GTIN/EAN barcode number, GS1 check digit verified
+	LENGTHS restricts the formats: GTIN(13) for EAN-13, GTIN() accepts GTIN-8, GTIN-12 (UPC-A), GTIN-13 and GTIN-14
+	Spaces and hyphens are allowed, the entry is normalized to digits only, see Normalizer
+*/
+func GTIN(lengths ...int) Rule {
+	if len(lengths) == 0 {
+		lengths = []int{8, 12, 13, 14}
+	}
+	return &gtin{lengths}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (g *gtin) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	field = g.Normalize(field)
+
+	for _, l := range g.lengths {
+		if len(field) == l && checkdigit.GS1.Verify(field) {
+			return nil, nil
+		}
+	}
+
+	return errors.New(errorMessages["gtin"]), nil
+}
+
+func (g *gtin) Normalize(field string) string {
+	return separatorReplacer.Replace(strings.TrimSpace(field))
+}
+
+// -----------------------
+
+type upc struct {
+}
+
+/*
+	UPC-A (12 digits) or UPC-E (8 digits), check digit verified
+	The entry is normalized to UPC-A, a UPC-E is expanded: "01234565" -> "012345000065", see Normalizer
+*/
+func UPC() Rule {
+	return &upc{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (u *upc) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	field = separatorReplacer.Replace(strings.TrimSpace(field))
+
+	if len(field) == 12 && checkdigit.GS1.Verify(field) {
+		return nil, nil
+	}
+
+	if _, err := ExpandUPCE(field); err == nil {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["upc"]), nil
+}
+
+func (u *upc) Normalize(field string) string {
+	field = separatorReplacer.Replace(strings.TrimSpace(field))
+	if expanded, err := ExpandUPCE(field); err == nil {
+		return expanded
+	}
+	return field
+}
+
+// -----------------------
+
+type isbnNormalized struct {
+	version uint32
+}
+
+/*
+	ISBN-10 or ISBN-13, check digit verified, the entry is converted to VERSION (10 or 13) without separators, see Normalizer
+	ISBN-13s starting with 979 do not have an ISBN-10, ISBNNormalized(10) rejects them.
+*/
+func ISBNNormalized(version uint32) Rule {
+	return &isbnNormalized{version}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (i *isbnNormalized) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if _, err := i.convert(field); err == nil {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["isbn"]), nil
+}
+
+func (i *isbnNormalized) convert(field string) (string, error) {
+	isbn13, err := ISBN10To13(field)
+	if err != nil { // not an ISBN-10, check it as an ISBN-13
+		isbn13 = separatorReplacer.Replace(strings.TrimSpace(field))
+		if len(isbn13) != 13 || !(strings.HasPrefix(isbn13, "978") || strings.HasPrefix(isbn13, "979")) || !checkdigit.GS1.Verify(isbn13) {
+			return "", ErrInvalidISBN
+		}
+	}
+
+	if i.version == 10 {
+		return ISBN13To10(isbn13)
+	}
+	return isbn13, nil
+}
+
+func (i *isbnNormalized) Normalize(field string) string {
+	if isbn, err := i.convert(field); err == nil {
+		return isbn
+	}
+	return field
+}
+
+// -----------------------
+
+type issn struct {
+}
+
+/*
+	International Standard Serial Number, 8 characters with a mod 11 check digit (0-9 or X)
+	The entry is normalized to "0317-8471", see Normalizer
+*/
+func ISSN() Rule {
+	return &issn{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (i *issn) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	field = strings.ToUpper(separatorReplacer.Replace(strings.TrimSpace(field)))
+
+	if len(field) == 8 && isDigits(field[:7]) && checkdigit.Mod11.Verify(field) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["issn"]), nil
+}
+
+func (i *issn) Normalize(field string) string {
+	field = strings.ToUpper(separatorReplacer.Replace(strings.TrimSpace(field)))
+	if len(field) != 8 {
+		return field
+	}
+	return field[:4] + "-" + field[4:]
+}
+
+// -----------------------
+
+type ismn struct {
+}
+
+/*
+	International Standard Music Number, ISMN-13 "979-0-2600-0043-8" or the older 10 character form "M-2600-0043-8"
+	The M stands for 979-0, the check digit is the same in both forms
+	The entry is normalized to ISMN-13 without separators, see Normalizer
+*/
+func ISMN() Rule {
+	return &ismn{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (i *ismn) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	field = i.Normalize(field)
+
+	if len(field) == 13 && strings.HasPrefix(field, "9790") && checkdigit.GS1.Verify(field) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["ismn"]), nil
+}
+
+func (i *ismn) Normalize(field string) string {
+	field = strings.ToUpper(separatorReplacer.Replace(strings.TrimSpace(field)))
+	if strings.HasPrefix(field, "M") {
+		return "9790" + field[1:]
+	}
+	return field
+}
@@ -0,0 +1,191 @@
+package formvalidator
+
+import (
+	"testing"
+)
+
+func Test_gtin(t *testing.T) {
+	var list = []struct {
+		field       string
+		lengths     []int
+		expectation bool
+		normalized  string
+	}{
+		{"", nil, true, ""},
+		{"4006381333931", nil, true, "4006381333931"},
+		{"400-6381-33393-1", nil, true, "4006381333931"},
+		{"96385074", nil, true, "96385074"},
+		{"036000291452", nil, true, "036000291452"},
+		{"10012345678902", nil, true, "10012345678902"},
+		{"4006381333931", []int{13}, true, "4006381333931"},
+		{"96385074", []int{13}, false, ""},
+		{"4006381333932", nil, false, ""},
+		{"400638133393", nil, false, ""},
+		{"40063813339A1", nil, false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = GTIN(l.lengths...)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("gtin(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("gtin(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_upc(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+		normalized  string
+	}{
+		{"", true, ""},
+		{"036000291452", true, "036000291452"},
+		{"0 36000 29145 2", true, "036000291452"},
+		{"01234565", true, "012345000065"}, // UPC-E
+		{"04252614", true, "042100005264"},
+		{"01234566", false, ""},
+		{"21234565", false, ""}, // number system must be 0 or 1
+		{"036000291453", false, ""},
+		{"0360002914", false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = UPC()
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("upc(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("upc(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_isbnConversion(t *testing.T) {
+	var list = []struct {
+		isbn10 string
+		isbn13 string
+	}{
+		{"0306406152", "9780306406157"},
+		{"080442957X", "9780804429573"},
+		{"0-19-853453-1", "9780198534532"},
+	}
+
+	for _, l := range list {
+		if r, err := ISBN10To13(l.isbn10); err != nil || r != l.isbn13 {
+			t.Errorf("ISBN10To13(%s): [%s, %v]. Expected: %s", l.isbn10, r, err, l.isbn13)
+		}
+
+		if r, err := ISBN13To10(l.isbn13); err != nil || r != separatorReplacer.Replace(l.isbn10) {
+			t.Errorf("ISBN13To10(%s): [%s, %v]. Expected: %s", l.isbn13, r, err, l.isbn10)
+		}
+	}
+
+	if _, err := ISBN13To10("9791090636071"); err != ErrInvalidISBN { // 979 has no ISBN-10
+		t.Errorf("ISBN13To10(9791090636071): [%v]. Expected: %v", err, ErrInvalidISBN)
+	}
+
+	if _, err := ISBN10To13("0306406153"); err != ErrInvalidISBN {
+		t.Errorf("ISBN10To13(0306406153): [%v]. Expected: %v", err, ErrInvalidISBN)
+	}
+}
+
+func Test_isbnNormalized(t *testing.T) {
+	var list = []struct {
+		field       string
+		version     uint32
+		expectation bool
+		normalized  string
+	}{
+		{"", 13, true, ""},
+		{"0-306-40615-2", 13, true, "9780306406157"},
+		{"978-0-306-40615-7", 13, true, "9780306406157"},
+		{"979-10-90636-07-1", 13, true, "9791090636071"},
+		{"978-0-306-40615-7", 10, true, "0306406152"},
+		{"080442957x", 10, true, "080442957X"},
+		{"979-10-90636-07-1", 10, false, ""},
+		{"978-0-306-40615-8", 13, false, ""},
+		{"0-306-40615-3", 13, false, ""},
+		{"4006381333931", 13, false, ""}, // EAN-13, not a book
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = ISBNNormalized(l.version)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("isbnNormalized(%s, %d): Valid[%t]. Expected: %t", l.field, l.version, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("isbnNormalized(%s, %d): Normalized[%s]. Expected: %s", l.field, l.version, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_issnISMN(t *testing.T) {
+	var list = []struct {
+		field       string
+		rule        Rule
+		expectation bool
+		normalized  string
+	}{
+		{"", ISSN(), true, ""},
+		{"0317-8471", ISSN(), true, "0317-8471"},
+		{"03178471", ISSN(), true, "0317-8471"},
+		{"2434-561x", ISSN(), true, "2434-561X"},
+		{"0317-8472", ISSN(), false, ""},
+		{"0317-847", ISSN(), false, ""},
+		{"X317-8471", ISSN(), false, ""},
+		{"", ISMN(), true, ""},
+		{"979-0-2600-0043-8", ISMN(), true, "9790260000438"},
+		{"M-2600-0043-8", ISMN(), true, "9790260000438"},
+		{"m 2306 7118 7", ISMN(), true, "9790230671187"},
+		{"979-0-2600-0043-9", ISMN(), false, ""},
+		{"978-0-306-40615-7", ISMN(), false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := l.rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("issnISMN(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := l.rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("issnISMN(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
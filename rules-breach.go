@@ -0,0 +1,155 @@
+package formvalidator
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+	Hashes of breached passwords by the first 5 hex characters of their SHA-1 (k-anonymity, only the prefix leaves the program)
+	Range returns the lines for the prefix in the Pwned Passwords format, "SUFFIX:COUNT" (35 hex characters, upper case)
+
+	NewRangeDir() reads downloaded range files, an HTTP client for the range API can implement it too.
+*/
+type RangeSource interface {
+	Range(ctx context.Context, prefix string) (io.ReadCloser, error)
+}
+
+type rangeFS struct {
+	fsys fs.FS
+}
+
+/*
+	Range files in a directory, one per prefix, named "21BD1" or "21BD1.txt" (the layout of the Pwned Passwords downloader)
+	Works offline, Ex: NotBreachedPassword(NewRangeDir("/var/lib/pwnedpasswords"))
+	A missing or empty directory is returned by New() (see Checker), a missing range file rejects the entry.
+*/
+func NewRangeDir(path string) RangeSource {
+	return rangeFS{os.DirFS(path)}
+}
+
+// range files in any fs.FS, like an embed.FS or fstest.MapFS for tests
+func NewRangeFS(fsys fs.FS) RangeSource {
+	return rangeFS{fsys}
+}
+
+func (r rangeFS) Range(ctx context.Context, prefix string) (io.ReadCloser, error) {
+	f, err := r.fsys.Open(prefix)
+	if errors.Is(err, fs.ErrNotExist) {
+		f, err = r.fsys.Open(prefix + ".txt")
+	}
+	return f, err
+}
+
+var ErrNoRangeFiles = errors.New("no range files")
+
+// the directory exists and has files, a download that did not run is found when the application starts
+func (r rangeFS) Check() error {
+	entries, err := fs.ReadDir(r.fsys, ".")
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return ErrNoRangeFiles
+	}
+	return nil
+}
+
+// the prefix for RangeSource and the suffix to look for, upper case hex
+func passwordHashRange(password string) (string, string) {
+	sum := sha1.Sum([]byte(password))
+	h := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return h[:5], h[5:]
+}
+
+/*
+	How often the password appears in breaches, 0 if it was not found
+	Lines with a count of 0 are padding (the range API adds them on request) and are not matches.
+*/
+func BreachCount(ctx context.Context, source RangeSource, password string) (int, error) {
+	prefix, suffix := passwordHashRange(password)
+
+	r, err := source.Range(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		hash, count, ok := strings.Cut(strings.TrimSpace(s.Text()), ":")
+		if !ok || !strings.EqualFold(hash, suffix) {
+			continue
+		}
+
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, err
+		}
+		return n, nil
+	}
+
+	return 0, s.Err()
+}
+
+// limit for one entry, in case a RangeSource uses the network
+const breachLookupTimeout = 5 * time.Second
+
+type notBreachedPassword struct {
+	source RangeSource
+}
+
+/*
+	Rejects passwords that appear in known data breaches, the extra data of "password_breached" is the count
+	The password is hashed with SHA-1, only the first 5 characters of the hash are passed to SOURCE.
+
+	A missing range file is an incomplete download, the entry is rejected with "breach_unchecked".
+	If SOURCE fails otherwise (a network error) the entry is accepted, use NotCommonPassword() too.
+	Ex: RuleChain(MinStrLen(8), NotCommonPassword(), NotBreachedPassword(NewRangeDir("/var/lib/pwnedpasswords")))
+*/
+func NotBreachedPassword(source RangeSource) Rule {
+	return &notBreachedPassword{source}
+}
+
+// the SOURCE is set up, if it implements Checker (NewRangeDir() does)
+func (n *notBreachedPassword) Check() error {
+	if c, ok := n.source.(Checker); ok {
+		return c.Check()
+	}
+	return nil
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (n *notBreachedPassword) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), breachLookupTimeout)
+	defer cancel()
+
+	count, err := BreachCount(ctx, n.source, field)
+	if errors.Is(err, fs.ErrNotExist) {
+		return errors.New(errorMessages["breach_unchecked"]), nil
+	}
+	if err == nil && count > 0 {
+		return errors.New(errorMessages["password_breached"]), []interface{}{count}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,99 @@
+package formvalidator
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+// range files in the Pwned Passwords format, "password" and "P@ssw0rd" are breached, "020F2" does not have "a password nobody would ever use 7f3a"
+var testRanges = fstest.MapFS{
+	"5BAA6":     {Data: []byte("003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:9545824\r\n1E4E3BE0EAF1E0C3B3BB9ABC8D9CC0C2F4A:0\r\n")},
+	"21BD1.txt": {Data: []byte("2DC183F740EE76F27B78EB39C8AD972A757:83129\n")},
+	"ABF7A":     {Data: []byte("AD6438836DBE526AA231ABDE2D0EEF74D43:12\nAD6438836DBE526AA231ABDE2D0EEF74D42:0\n")}, // padding
+	"020F2":     {Data: []byte("0005AD76BD555C1D6D771DE417A4B87E4B4:10\n")},
+}
+
+// a range API that does not answer
+type failingRangeSource struct{}
+
+func (failingRangeSource) Range(ctx context.Context, prefix string) (io.ReadCloser, error) {
+	return nil, errors.New("connection refused")
+}
+
+func Test_notBreachedPassword(t *testing.T) {
+	var list = []struct {
+		field string
+		key   string
+		count int
+	}{
+		{"", "", 0},
+		{"password", "password_breached", 9545824},
+		{"P@ssw0rd", "password_breached", 83129}, // "21BD1.txt"
+		{"correct horse battery staple", "", 0},  // padding, count 0
+		{"Password", "breach_unchecked", 0},      // no range file, the download is incomplete
+		{"a password nobody would ever use 7f3a", "", 0},
+	}
+
+	for _, l := range list {
+		e, data := NotBreachedPassword(NewRangeFS(testRanges)).Validate([]string{l.field}, testMessages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("notBreachedPassword(%s): Error[%s]. Expected: %s", l.field, key, l.key)
+		}
+
+		if l.count > 0 && (len(data) != 1 || data[0] != l.count) {
+			t.Errorf("notBreachedPassword(%s): Data[%v]. Expected: %d", l.field, data, l.count)
+		}
+	}
+
+	if e, _ := NotBreachedPassword(failingRangeSource{}).Validate([]string{"password"}, testMessages); e != nil {
+		t.Errorf("notBreachedPassword(failing source): Error[%s]. Expected: none", messageKey(e))
+	}
+}
+
+func Test_rangeDirCheck(t *testing.T) {
+	dir := t.TempDir()
+	rules := func(path string) map[string][]Rule {
+		return map[string][]Rule{"password": {NotBreachedPassword(NewRangeDir(path))}}
+	}
+
+	if err, _ := New(rules(filepath.Join(dir, "missing"))); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("New(missing range directory): Error[%v]. Expected: %v", err, fs.ErrNotExist)
+	}
+
+	if err, _ := New(rules(dir)); !errors.Is(err, ErrNoRangeFiles) {
+		t.Errorf("New(empty range directory): Error[%v]. Expected: %v", err, ErrNoRangeFiles)
+	}
+
+	os.WriteFile(filepath.Join(dir, "5BAA6"), testRanges["5BAA6"].Data, 0644)
+	if err, _ := New(rules(dir)); err != nil {
+		t.Errorf("New(range directory): Error[%v]. Expected: none", err)
+	}
+
+	if err, _ := New(map[string][]Rule{"password": {NotBreachedPassword(failingRangeSource{})}}); err != nil {
+		t.Errorf("New(range source without Check): Error[%v]. Expected: none", err)
+	}
+}
+
+func Test_breachCount(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "5BAA6"), testRanges["5BAA6"].Data, 0644)
+
+	if n, err := BreachCount(context.Background(), NewRangeDir(dir), "password"); err != nil || n != 9545824 {
+		t.Errorf("BreachCount(password): [%d, %v]. Expected: 9545824", n, err)
+	}
+
+	if _, err := BreachCount(context.Background(), NewRangeDir(dir), "P@ssw0rd"); err == nil {
+		t.Errorf("BreachCount(P@ssw0rd): no error. Expected: missing range file")
+	}
+
+	bad := fstest.MapFS{"5BAA6": {Data: []byte("1E4C9B93F3F0682250B6CF8331B7EE68FD8:many\n")}}
+	if _, err := BreachCount(context.Background(), NewRangeFS(bad), "password"); err == nil {
+		t.Errorf("BreachCount(bad count): no error")
+	}
+}
@@ -0,0 +1,304 @@
+package formvalidator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// card brands (payment networks) detected from the IIN, the first digits of a card number
+type CardBrand string
+
+const (
+	CardVisa       CardBrand = "visa"
+	CardMastercard CardBrand = "mastercard"
+	CardAmex       CardBrand = "amex"
+	CardDiscover   CardBrand = "discover"
+	CardJCB        CardBrand = "jcb"
+	CardDiners     CardBrand = "diners"
+	CardUnionPay   CardBrand = "unionpay"
+	CardMaestro    CardBrand = "maestro"
+)
+
+/*
+	IIN ranges, inclusive, compared on the first len(low) digits
+	The order matters: the first match wins, so co-branded ranges (Discover inside UnionPay's 62) come first.
+*/
+var cardIINRanges = []struct {
+	brand CardBrand
+	low   string
+	high  string
+}{
+	{CardAmex, "34", "34"},
+	{CardAmex, "37", "37"},
+	{CardDiners, "300", "305"},
+	{CardDiners, "3095", "3095"},
+	{CardDiners, "36", "36"},
+	{CardDiners, "38", "39"},
+	{CardJCB, "3528", "3589"},
+	{CardVisa, "4", "4"},
+	{CardMaestro, "5018", "5018"},
+	{CardMaestro, "5020", "5020"},
+	{CardMaestro, "5038", "5038"},
+	{CardMaestro, "5893", "5893"},
+	{CardMastercard, "51", "55"},
+	{CardMastercard, "2221", "2720"},
+	{CardDiscover, "6011", "6011"},
+	{CardDiscover, "622126", "622925"},
+	{CardDiscover, "644", "649"},
+	{CardDiscover, "65", "65"},
+	{CardUnionPay, "62", "62"},
+	{CardMaestro, "6304", "6304"},
+	{CardMaestro, "6759", "6759"},
+	{CardMaestro, "6761", "6763"},
+}
+
+// valid lengths of a card number for each brand, CreditCard() accepts 13 to 19 digits
+var cardLengths = map[CardBrand][]int{
+	CardVisa:       {13, 16, 19},
+	CardMastercard: {16},
+	CardAmex:       {15},
+	CardDiscover:   {16, 17, 18, 19},
+	CardJCB:        {16, 17, 18, 19},
+	CardDiners:     {14, 15, 16, 17, 18, 19},
+	CardUnionPay:   {16, 17, 18, 19},
+	CardMaestro:    {13, 14, 15, 16, 17, 18, 19},
+}
+
+/*
+	Detect the brand of a card number from its IIN, spaces and hyphens are ignored
+	Returns "" if the brand is unknown, the length and the checksum are not tested, see CreditCardBrands()
+*/
+func DetectCardBrand(number string) CardBrand {
+	number = (&creditCard{}).Normalize(number)
+	if !isDigits(number) {
+		return ""
+	}
+
+	for _, r := range cardIINRanges {
+		if len(number) < len(r.low) {
+			continue
+		}
+
+		prefix := number[:len(r.low)]
+		if prefix >= r.low && prefix <= r.high { // same length, digits compare like numbers
+			return r.brand
+		}
+	}
+
+	return ""
+}
+
+// digits, optionally separated by single spaces or hyphens: "4716 4615 8332 2103"
+func hasDigitGroups(s string) bool {
+	for n, c := range s {
+		if c == ' ' || c == '-' {
+			if n == 0 || s[n-1] < '0' || s[n-1] > '9' {
+				return false
+			}
+			continue
+		}
+
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return len(s) > 0 && s[len(s)-1] >= '0' && s[len(s)-1] <= '9'
+}
+
+// -----------------------
+
+type creditCardBrands struct {
+	creditCard
+	brands []CardBrand
+}
+
+/*
+	A credit card number of a known brand with a valid length for that brand, see CreditCard()
+	If BRANDS are given, only those are accepted. Example: CreditCardBrands(false, CardVisa, CardMastercard)
+*/
+func CreditCardBrands(allowTestingNumbers bool, brands ...CardBrand) Rule {
+	return &creditCardBrands{creditCard{allowTestingNumbers}, brands}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (c *creditCardBrands) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	// Luhn algorithm, testing numbers
+	if err, data := c.creditCard.Validate(fields, errorMessages); err != nil {
+		return err, data
+	}
+
+	number := c.Normalize(field)
+	brand := DetectCardBrand(number)
+
+	validLength := false
+	for _, l := range cardLengths[brand] {
+		if len(number) == l {
+			validLength = true
+		}
+	}
+
+	if !validLength {
+		return errors.New(errorMessages["credit_card"]), nil
+	}
+
+	if len(c.brands) == 0 {
+		return nil, nil
+	}
+
+	for _, b := range c.brands {
+		if b == brand {
+			return nil, nil
+		}
+	}
+
+	return errors.New(errorMessages["card_brand"]), nil
+}
+
+// -----------------------
+
+type cardExpiry struct {
+	month  string
+	config *DateConfig
+}
+
+/*
+	For the expiration year of a card (YY or YYYY), with the month from another field: CardExpiry(form.Get("ExpMonth"), nil)
+	A card is valid through the last day of its expiration month, DateConfig sets the clock and the time zone. (Layouts are not used)
+*/
+func CardExpiry(month string, c *DateConfig) Rule {
+	return &cardExpiry{month, c}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (e *cardExpiry) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	return checkCardExpiry(e.month, field, e.config, errorMessages)
+}
+
+// -----------------------
+
+type cardExpiryDate struct {
+	config *DateConfig
+}
+
+// The expiration date of a card in one field (format: MM/YY or MM/YYYY), see CardExpiry()
+func CardExpiryDate(c *DateConfig) Rule {
+	return &cardExpiryDate{c}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (e *cardExpiryDate) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	month, year, found := strings.Cut(field, "/")
+	if !found {
+		return errors.New(errorMessages["card_expiry"]), nil
+	}
+
+	return checkCardExpiry(strings.TrimSpace(month), strings.TrimSpace(year), e.config, errorMessages)
+}
+
+// month: 1-12, year: YY or YYYY, at most 20 years from now
+func checkCardExpiry(month, year string, c *DateConfig, errorMessages map[string]string) (error, []interface{}) {
+	m, err := strconv.Atoi(month)
+	if err != nil || !isDigits(month) || len(month) > 2 || m < 1 || m > 12 {
+		return errors.New(errorMessages["card_expiry"]), nil
+	}
+
+	y, err := strconv.Atoi(year)
+	if err != nil || !isDigits(year) || (len(year) != 2 && len(year) != 4) {
+		return errors.New(errorMessages["card_expiry"]), nil
+	}
+	if len(year) == 2 {
+		y += 2000
+	}
+
+	now := c.now()
+	if y > now.Year()+20 {
+		return errors.New(errorMessages["card_expiry"]), nil
+	}
+
+	// the first moment after the expiration month
+	if !now.Before(time.Date(y, time.Month(m)+1, 1, 0, 0, 0, 0, c.location())) {
+		return errors.New(errorMessages["card_expired"]), nil
+	}
+
+	return nil, nil
+}
+
+// -----------------------
+
+type cvv struct {
+	cardNumber string
+}
+
+/*
+	Card security code (CVV/CVC/CID), the length depends on the brand of the card number from another field: CVV(form.Get("CardNumber"))
+	American Express uses 4 digits, other brands 3, and either is accepted if the brand is unknown.
+*/
+func CVV(cardNumber string) Rule {
+	return &cvv{cardNumber}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (v *cvv) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if !isDigits(field) {
+		return errors.New(errorMessages["cvv"]), nil
+	}
+
+	switch DetectCardBrand(v.cardNumber) {
+	case CardAmex:
+		if len(field) == 4 {
+			return nil, nil
+		}
+	case "":
+		if len(field) == 3 || len(field) == 4 {
+			return nil, nil
+		}
+	default:
+		if len(field) == 3 {
+			return nil, nil
+		}
+	}
+
+	return errors.New(errorMessages["cvv"]), nil
+}
@@ -0,0 +1,174 @@
+package formvalidator
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_detectCardBrand(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation CardBrand
+	}{
+		{"", ""},
+		{"abc", ""},
+		{"4716461583322103", CardVisa},
+		{"4716 4615 8332 2103", CardVisa},
+		{"5398228707871527", CardMastercard},
+		{"2223003122003222", CardMastercard},
+		{"2221000000000009", CardMastercard},
+		{"2720990000000007", CardMastercard},
+		{"2721000000000000", ""},
+		{"375556917985515", CardAmex},
+		{"340000000000009", CardAmex},
+		{"6011111111111117", CardDiscover},
+		{"6221260000000000", CardDiscover},
+		{"6440000000000005", CardDiscover},
+		{"6500000000000002", CardDiscover},
+		{"3530111333300000", CardJCB},
+		{"3528000000000007", CardJCB},
+		{"36050234196908", CardDiners},
+		{"30569309025904", CardDiners},
+		{"30950000000000", CardDiners},
+		{"6200000000000005", CardUnionPay},
+		{"6759649826438453", CardMaestro},
+		{"5018000000000009", CardMaestro},
+		{"9999999999999999", ""},
+	}
+
+	for _, l := range list {
+		if brand := DetectCardBrand(l.field); brand != l.expectation {
+			t.Errorf("DetectCardBrand(%s): Result[%s]. Expected: %s", l.field, brand, l.expectation)
+		}
+	}
+}
+
+func Test_creditCardBrands(t *testing.T) {
+	var list = []struct {
+		field       string
+		brands      []CardBrand
+		expectation string
+	}{
+		{"", nil, ""},
+		{"4716 4615 8332 2103", nil, ""},
+		{"4716461583322103", []CardBrand{CardVisa, CardMastercard}, ""},
+		{"2223 0031 2200 3222", []CardBrand{CardVisa, CardMastercard}, ""},
+		{"375556917985515", []CardBrand{CardVisa, CardMastercard}, "card_brand"},
+		{"6200000000000005", []CardBrand{CardUnionPay}, ""},
+		{"4000000000006", nil, ""},                      // Visa, 13 digits
+		{"4000000000000000006", nil, ""},                // Visa, 19 digits
+		{"30000000000004", []CardBrand{CardDiners}, ""}, // Diners, 14 digits
+		{"5018000000007", nil, ""},                      // Maestro, 13 digits
+		{"501800000009", nil, "credit_card"},            // Maestro, 12 digits
+		{"37555691798551", nil, "credit_card"},
+		{"2720990000000007", nil, ""},
+		{"27209900000000072", nil, "credit_card"},
+		{"9999999999999995", nil, "credit_card"}, // unknown brand
+		{"4242424242424242", nil, "credit_card"}, // testing number
+	}
+
+	for _, l := range list {
+		err, _ := CreditCardBrands(false, l.brands...).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("creditCardBrands(%s, %v): Result[%s]. Expected: %s", l.field, l.brands, result, l.expectation)
+		}
+	}
+
+	for brand, lengths := range cardLengths {
+		for _, l := range lengths {
+			if l < 13 || l > 19 {
+				t.Errorf("cardLengths[%s]: %d digits, CreditCard() accepts 13 to 19", brand, l)
+			}
+		}
+	}
+
+	if n := CreditCardBrands(false).(Normalizer).Normalize(" 4716-4615-8332-2103"); n != "4716461583322103" {
+		t.Errorf("creditCardBrands: Normalized[%s]", n)
+	}
+}
+
+func Test_cardExpiry(t *testing.T) {
+	// Wednesday 15-03-2017
+	c := &DateConfig{Now: func() time.Time { return time.Date(2017, 3, 15, 10, 30, 0, 0, time.UTC) }}
+
+	var list = []struct {
+		month       string
+		year        string
+		expectation string
+	}{
+		{"3", "", ""},
+		{"3", "2017", ""},
+		{"03", "17", ""},
+		{"12", "2017", ""},
+		{"1", "2018", ""},
+		{"2", "2017", "card_expired"},
+		{"12", "16", "card_expired"},
+		{"13", "2018", "card_expiry"},
+		{"0", "2018", "card_expiry"},
+		{"", "2018", "card_expiry"},
+		{"-1", "2018", "card_expiry"},
+		{"1", "218", "card_expiry"},
+		{"1", "2050", "card_expiry"},
+		{"1", "20x8", "card_expiry"},
+	}
+
+	for _, l := range list {
+		err, _ := CardExpiry(l.month, c).Validate([]string{l.year}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("cardExpiry(%s, %s): Result[%s]. Expected: %s", l.month, l.year, result, l.expectation)
+		}
+	}
+
+	var dates = []struct {
+		field       string
+		expectation string
+	}{
+		{"", ""},
+		{"03/17", ""},
+		{"3/2017", ""},
+		{"04 / 19", ""},
+		{"02/17", "card_expired"},
+		{"0317", "card_expiry"},
+		{"03-17", "card_expiry"},
+		{"03/", "card_expiry"},
+	}
+
+	for _, l := range dates {
+		err, _ := CardExpiryDate(c).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("cardExpiryDate(%s): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+}
+
+func Test_cvv(t *testing.T) {
+	var list = []struct {
+		field       string
+		cardNumber  string
+		expectation bool
+	}{
+		{"", "4716461583322103", true},
+		{"123", "4716461583322103", true},
+		{"1234", "4716461583322103", false},
+		{"1234", "375556917985515", true},
+		{"123", "3755 5691 7985 515", false},
+		{"123", "", true},
+		{"1234", "", true},
+		{"12", "", false},
+		{"12a", "4716461583322103", false},
+		{" 123", "4716461583322103", false},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = CVV(l.cardNumber)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("cvv(%s, %s): Valid[%t]. Expected: %t", l.field, l.cardNumber, valid, l.expectation)
+		}
+	}
+}
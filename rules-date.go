@@ -0,0 +1,665 @@
+package formvalidator
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+	Layouts for the date rules, they use the reference time of package "time" (Mon Jan 2 15:04:05 MST 2006)
+	Any layout from package "time" can be used as well.
+*/
+const (
+	LayoutDate     = "02-01-2006"          // DD-MM-YYYY, IsDate()
+	LayoutTime     = "15:04:05"            // HH:MM:SS, IsTime()
+	LayoutDateTime = "02-01-2006 15:04:05" // IsDateTime()
+
+	LayoutISO8601Date     = "2006-01-02"
+	LayoutISO8601DateTime = "2006-01-02T15:04:05"
+	LayoutRFC3339         = time.RFC3339 // fractional seconds are accepted when parsing
+
+	// formats that browsers submit for HTML5 inputs, seconds are only sent when the 'step' attribute asks for them
+	LayoutHTMLDate                 = "2006-01-02"          // <input type="date">
+	LayoutHTMLTime                 = "15:04"               // <input type="time">
+	LayoutHTMLTimeSeconds          = "15:04:05"            // <input type="time" step="1">
+	LayoutHTMLDateTimeLocal        = "2006-01-02T15:04"    // <input type="datetime-local">
+	LayoutHTMLDateTimeLocalSeconds = "2006-01-02T15:04:05" // <input type="datetime-local" step="1">
+	LayoutHTMLMonth                = "2006-01"             // <input type="month">
+	LayoutHTMLWeek                 = "2006-Www"            // <input type="week"> (ISO 8601 week, 1990-W52), not a layout of package "time"
+)
+
+// example values in error messages are this time formatted with the layout
+var layoutExample = time.Date(1990, 12, 31, 14, 23, 56, 0, time.UTC)
+
+// human-readable layout for error messages, "02-01-2006" -> "DD-MM-YYYY"
+var layoutReplacer = strings.NewReplacer(
+	"2006", "YYYY",
+	"Z07:00", "±HH:MM",
+	"-07:00", "±HH:MM",
+	"January", "MMMM",
+	"Jan", "MMM",
+	"Monday", "Weekday",
+	"Mon", "Day",
+	"01", "MM",
+	"02", "DD",
+	"15", "HH",
+	"03", "HH",
+	"04", "MM",
+	"05", "SS",
+	"PM", "AM/PM",
+	"1", "M",
+	"2", "D",
+	"3", "H",
+)
+
+// data for the "date_layout", "time_layout", and "date_time_layout" error messages: format and an example
+func layoutData(layout string) []interface{} {
+	return []interface{}{layoutReplacer.Replace(layout), formatInLayout(layoutExample, layout)}
+}
+
+func formatInLayout(t time.Time, layout string) string {
+	if layout == LayoutHTMLWeek {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week)
+	}
+
+	return t.Format(layout)
+}
+
+/*
+	Parse a date and/or time with the first matching layout
+	Entries without a time zone are in 'loc'
+*/
+func parseInLayouts(layouts []string, value string, loc *time.Location) (t time.Time, err error) {
+	for _, layout := range layouts {
+		if layout == LayoutHTMLWeek {
+			t, err = parseISOWeek(value, loc)
+		} else {
+			t, err = time.ParseInLocation(layout, value, loc)
+		}
+
+		if err == nil {
+			return t, nil
+		}
+	}
+
+	return t, err
+}
+
+var ErrInvalidWeek = errors.New("String is not an ISO 8601 week! (YYYY-Www)")
+
+// returns the Monday of an ISO 8601 week, "2004-W53"
+func parseISOWeek(value string, loc *time.Location) (time.Time, error) {
+	if len(value) != 8 || value[4:6] != "-W" || !isDigits(value[:4]) || !isDigits(value[6:]) {
+		return time.Time{}, ErrInvalidWeek
+	}
+
+	year, _ := strconv.Atoi(value[:4])
+	week, _ := strconv.Atoi(value[6:])
+
+	// December 28th is always in the last week of the year
+	if _, weeks := time.Date(year, 12, 28, 0, 0, 0, 0, loc).ISOWeek(); week < 1 || week > weeks {
+		return time.Time{}, ErrInvalidWeek
+	}
+
+	// January 4th is always in the first week
+	jan4 := time.Date(year, 1, 4, 0, 0, 0, 0, loc)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+
+	return monday.AddDate(0, 0, (week-1)*7), nil
+}
+
+// -----------------------
+
+type dateLayout struct {
+	key     string // error message
+	layouts []string
+	data    []interface{} // of the error message, nil for the messages with the format written in them
+}
+
+func newDateLayout(key, defaultLayout string, layouts []string) *dateLayout {
+	if len(layouts) == 0 {
+		layouts = []string{defaultLayout}
+	}
+	return &dateLayout{key, layouts, layoutData(layouts[0])}
+}
+
+// Is a string a date? (format: DD-MM-YYYY)
+func IsDate() Rule {
+	return &dateLayout{"date", []string{LayoutDate}, nil}
+}
+
+// Is a string a time? (format: HH:MM:SS)
+func IsTime() Rule {
+	return &dateLayout{"time", []string{LayoutTime}, nil}
+}
+
+// Is a string a datetime? (format: DD-MM-YYYY HH:MM:SS) These are used for timestamps.
+func IsDateTime() Rule {
+	return &dateLayout{"date_time", []string{LayoutDateTime}, nil}
+}
+
+/*
+	Is a string a date in one of the layouts? The first layout is shown in the error message ("date_layout").
+	Example: IsDateLayout(LayoutHTMLDate) for <input type="date">
+*/
+func IsDateLayout(layouts ...string) Rule {
+	return newDateLayout("date_layout", LayoutDate, layouts)
+}
+
+// see IsDateLayout, example: IsTimeLayout(LayoutHTMLTime, LayoutHTMLTimeSeconds)
+func IsTimeLayout(layouts ...string) Rule {
+	return newDateLayout("time_layout", LayoutTime, layouts)
+}
+
+// see IsDateLayout, example: IsDateTimeLayout(LayoutRFC3339)
+func IsDateTimeLayout(layouts ...string) Rule {
+	return newDateLayout("date_time_layout", LayoutDateTime, layouts)
+}
+
+// date in the locale's format, "31.12.1990" for LocaleDeDE
+func IsDateLocale(l *Locale) Rule {
+	if l == nil {
+		l = LocaleEnUS
+	}
+	return IsDateLayout(l.DateLayout)
+}
+
+// time in the locale's format, "2:23:56 PM" for LocaleEnUS
+func IsTimeLocale(l *Locale) Rule {
+	if l == nil {
+		l = LocaleEnUS
+	}
+	return IsTimeLayout(l.TimeLayout)
+}
+
+// date and time in the locale's format, "31.12.1990 14:23:56" for LocaleDeDE
+func IsDateTimeLocale(l *Locale) Rule {
+	if l == nil {
+		l = LocaleEnUS
+	}
+	return IsDateTimeLayout(l.DateTimeLayout)
+}
+
+/*
+	The error message shows the expected format and an example for the first layout: "(DD-MM-YYYY) [Ex: 31-12-1990]"
+	IsDate(), IsTime() and IsDateTime() use messages with the format written in them, without extra data.
+	return error - FormError with message and extra data if revelant
+*/
+func (d *dateLayout) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if _, err := parseInLayouts(d.layouts, field, time.UTC); err == nil {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages[d.key]), d.data
+}
+
+/*
+	DateConfig controls how the date range rules parse an entry and what "now" is, a nil *DateConfig uses the defaults.
+
+	Layouts - accepted layouts, the first is shown in error messages (default: LayoutDate)
+	Location - time zone of entries without an offset and of "today" (default: time.UTC)
+	Now - the clock, replace it for deterministic tests (default: time.Now)
+
+	Example: &DateConfig{Layouts: []string{LayoutHTMLDate}, Location: berlin}
+*/
+type DateConfig struct {
+	Layouts  []string
+	Location *time.Location
+	Now      func() time.Time
+}
+
+func (c *DateConfig) layouts() []string {
+	if c == nil || len(c.Layouts) == 0 {
+		return []string{LayoutDate}
+	}
+	return c.Layouts
+}
+
+func (c *DateConfig) location() *time.Location {
+	if c == nil || c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+func (c *DateConfig) now() time.Time {
+	if c == nil || c.Now == nil {
+		return time.Now().In(c.location())
+	}
+	return c.Now().In(c.location())
+}
+
+// midnight at the start of the current day
+func (c *DateConfig) today() time.Time {
+	y, m, d := c.now().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, c.location())
+}
+
+// does the first layout have a time of day?
+func (c *DateConfig) hasClock() bool {
+	return strings.ContainsAny(layoutReplacer.Replace(c.layouts()[0]), "HS")
+}
+
+/*
+	parse an entry, the error is for the "date_layout", "time_layout", or "date_time_layout" message
+	date-only entries are compared to today, entries with a time of day to now
+*/
+func (c *DateConfig) parse(field string, errorMessages map[string]string) (time.Time, error, []interface{}) {
+	t, err := parseInLayouts(c.layouts(), field, c.location())
+	if err == nil {
+		return t, nil, nil
+	}
+
+	key := "date_layout"
+	display := layoutReplacer.Replace(c.layouts()[0])
+	if c.hasClock() && strings.ContainsAny(display, "YD") {
+		key = "date_time_layout"
+	} else if c.hasClock() {
+		key = "time_layout"
+	}
+
+	return t, errors.New(errorMessages[key]), layoutData(c.layouts()[0])
+}
+
+// "now" with the precision of the layout
+func (c *DateConfig) current() time.Time {
+	if c.hasClock() {
+		return c.now()
+	}
+	return c.today()
+}
+
+// -----------------------
+
+type dateBetween struct {
+	min    time.Time
+	max    time.Time
+	config *DateConfig
+}
+
+/*
+	Is the date between MIN and MAX? (inclusive) A zero time.Time means there is no limit on that side.
+	Example: DateBetween(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}, nil)
+*/
+func DateBetween(min, max time.Time, c *DateConfig) Rule {
+	return &dateBetween{min, max, c}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (d *dateBetween) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	t, err, data := d.config.parse(field, errorMessages)
+	if err != nil {
+		return err, data
+	}
+
+	return checkDateBounds(t, d.min, d.max, errorMessages)
+}
+
+// zero bounds are ignored
+func checkDateBounds(t, min, max time.Time, errorMessages map[string]string) (error, []interface{}) {
+	if !min.IsZero() && t.Before(min) {
+		return errors.New(errorMessages["date_min"]), []interface{}{min}
+	}
+
+	if !max.IsZero() && t.After(max) {
+		return errors.New(errorMessages["date_max"]), []interface{}{max}
+	}
+
+	return nil, nil
+}
+
+// -----------------------
+
+type dateWithinDays struct {
+	from   int
+	to     int
+	config *DateConfig
+}
+
+/*
+	Is the date within a window of days relative to today? (inclusive)
+	Example: DateWithinDays(0, 30, nil) for "delivery date within the next 30 days", DateWithinDays(-7, 0, nil) for "in the last week"
+*/
+func DateWithinDays(from, to int, c *DateConfig) Rule {
+	return &dateWithinDays{from, to, c}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (d *dateWithinDays) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	t, err, data := d.config.parse(field, errorMessages)
+	if err != nil {
+		return err, data
+	}
+
+	today := d.config.today()
+	first := today.AddDate(0, 0, d.from)
+	last := today.AddDate(0, 0, d.to)
+
+	if t.Before(first) {
+		return errors.New(errorMessages["date_min"]), []interface{}{first}
+	}
+
+	if !t.Before(last.AddDate(0, 0, 1)) { // any time on the last day is fine
+		return errors.New(errorMessages["date_max"]), []interface{}{last}
+	}
+
+	return nil, nil
+}
+
+// -----------------------
+
+type notInFuture struct {
+	config *DateConfig
+}
+
+// The date cannot be after today, or now for layouts with a time of day. (birthdates, ...)
+func NotInFuture(c *DateConfig) Rule {
+	return &notInFuture{c}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (n *notInFuture) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	t, err, data := n.config.parse(field, errorMessages)
+	if err != nil {
+		return err, data
+	}
+
+	if t.After(n.config.current()) {
+		return errors.New(errorMessages["date_future"]), nil
+	}
+
+	return nil, nil
+}
+
+// -----------------------
+
+type notInPast struct {
+	config *DateConfig
+}
+
+// The date cannot be before today, or now for layouts with a time of day. (appointments, ...)
+func NotInPast(c *DateConfig) Rule {
+	return &notInPast{c}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (n *notInPast) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	t, err, data := n.config.parse(field, errorMessages)
+	if err != nil {
+		return err, data
+	}
+
+	if t.Before(n.config.current()) {
+		return errors.New(errorMessages["date_past"]), nil
+	}
+
+	return nil, nil
+}
+
+// -----------------------
+
+type age struct {
+	min    int // -1 for no limit
+	max    int
+	config *DateConfig
+}
+
+// Is a birthdate at least MIN years ago? Example: MinAge(18, nil)
+func MinAge(min int, c *DateConfig) Rule {
+	return &age{min, -1, c}
+}
+
+// Is a birthdate at most MAX years ago? (age in full years)
+func MaxAge(max int, c *DateConfig) Rule {
+	return &age{-1, max, c}
+}
+
+// full years between a birthdate and today, a birthday on February 29th is celebrated on March 1st in common years
+func ageOn(birthdate, today time.Time) int {
+	years := today.Year() - birthdate.Year()
+	if today.Month() < birthdate.Month() || (today.Month() == birthdate.Month() && today.Day() < birthdate.Day()) {
+		years--
+	}
+	return years
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (a *age) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	t, err, data := a.config.parse(field, errorMessages)
+	if err != nil {
+		return err, data
+	}
+
+	years := ageOn(t, a.config.today())
+
+	if a.min >= 0 && years < a.min {
+		return errors.New(errorMessages["min_age"]), []interface{}{a.min}
+	}
+
+	if a.max >= 0 && years > a.max {
+		return errors.New(errorMessages["max_age"]), []interface{}{a.max}
+	}
+
+	return nil, nil
+}
+
+// -----------------------
+
+type timeOfDayBetween struct {
+	start  time.Time
+	end    time.Time
+	err    error
+	config *DateConfig
+}
+
+/*
+	Is the time of day between START and END? (inclusive, format: HH:MM) The entry can be a time or a date with a time.
+	Example: TimeOfDayBetween("09:00", "17:00", &DateConfig{Layouts: []string{LayoutHTMLDateTimeLocal}}) for business hours
+	Invalid START or END values are returned by New() (see Checker).
+*/
+func TimeOfDayBetween(start, end string, c *DateConfig) Rule {
+	s, err := time.Parse(LayoutHTMLTime, start)
+	if err == nil {
+		var e time.Time
+		if e, err = time.Parse(LayoutHTMLTime, end); err == nil {
+			return &timeOfDayBetween{s, e, nil, c}
+		}
+	}
+
+	return &timeOfDayBetween{err: fmt.Errorf("TimeOfDayBetween(%q, %q): %w", start, end, err), config: c}
+}
+
+func (b *timeOfDayBetween) Check() error {
+	return b.err
+}
+
+// seconds since midnight
+func clockSeconds(t time.Time) int {
+	return t.Hour()*3600 + t.Minute()*60 + t.Second()
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (b *timeOfDayBetween) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	t, err, data := b.config.parse(field, errorMessages)
+	if err != nil {
+		return err, data
+	}
+
+	// bad bounds reject everything, if the rule was not checked by New()
+	s := clockSeconds(t.In(b.config.location()))
+	if b.err == nil && s >= clockSeconds(b.start) && s <= clockSeconds(b.end) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["time_between"]), []interface{}{b.start, b.end}
+}
+
+// -----------------------
+
+type onWeekdays struct {
+	days   []time.Weekday
+	config *DateConfig
+}
+
+// Is the date on one of the days of the week? Example: OnWeekdays([]time.Weekday{time.Monday, time.Tuesday, ...}, nil)
+func OnWeekdays(days []time.Weekday, c *DateConfig) Rule {
+	return &onWeekdays{days, c}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (o *onWeekdays) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	t, err, data := o.config.parse(field, errorMessages)
+	if err != nil {
+		return err, data
+	}
+
+	weekday := t.In(o.config.location()).Weekday()
+	for _, d := range o.days {
+		if d == weekday {
+			return nil, nil
+		}
+	}
+
+	return errors.New(errorMessages["weekday"]), nil
+}
+
+// -----------------------
+
+type dateRange struct {
+	start   string // form field names
+	end     string
+	minDays int
+	maxDays int
+	config  *DateConfig
+}
+
+/*
+	Validates a pair of date fields together (bookings, reports, ...), add it with FormValidator.AddGroup
+	Both must be dates in the layout of the DateConfig and END cannot be before START.
+	MINDAYS and MAXDAYS limit the length of the range, 0 for no limit. Example: DateRange("From", "To", 0, 90, nil) for "no more than 90 days"
+
+	An invalid date is reported on its own field ("date_layout" or "date_time_layout" message), the order and the length of the range on the END field.
+	Blank fields are skipped, use Required()
+*/
+func DateRange(startField, endField string, minDays, maxDays int, c *DateConfig) GroupRule {
+	return &dateRange{startField, endField, minDays, maxDays, c}
+}
+
+func (d *dateRange) ValidateGroup(form url.Values, errorMessages map[string]string) map[string][]FormError {
+	errs := make(map[string][]FormError)
+
+	startField := getFirstKey(form[d.start])
+	endField := getFirstKey(form[d.end])
+
+	// if one is blank, it is fine
+	if len(startField) == 0 || len(endField) == 0 {
+		return errs
+	}
+
+	start, err, data := d.config.parse(startField, errorMessages)
+	if err != nil {
+		errs[d.start] = appendError(errs[d.start], &FormError{Str: err.Error(), Data: data})
+	}
+
+	end, err, data := d.config.parse(endField, errorMessages)
+	if err != nil {
+		errs[d.end] = appendError(errs[d.end], &FormError{Str: err.Error(), Data: data})
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	if end.Before(start) {
+		errs[d.end] = appendError(errs[d.end], &FormError{Str: errorMessages["date_range_order"]})
+	} else if d.maxDays > 0 && end.After(start.AddDate(0, 0, d.maxDays)) {
+		errs[d.end] = appendError(errs[d.end], &FormError{Str: errorMessages["date_range_max"], Data: []interface{}{d.maxDays}})
+	} else if d.minDays > 0 && end.Before(start.AddDate(0, 0, d.minDays)) {
+		errs[d.end] = appendError(errs[d.end], &FormError{Str: errorMessages["date_range_min"], Data: []interface{}{d.minDays}})
+	}
+
+	return errs
+}
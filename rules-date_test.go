@@ -0,0 +1,379 @@
+package formvalidator
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func Test_isDateLayout(t *testing.T) {
+	var list = []struct {
+		field       string
+		layouts     []string
+		expectation bool
+	}{
+		{"", []string{LayoutHTMLDate}, true},
+		{"1990-12-31", []string{LayoutHTMLDate}, true},
+		{"2000-02-29", []string{LayoutISO8601Date}, true},
+		{"1999-02-29", []string{LayoutISO8601Date}, false},
+		{"31-12-1990", []string{LayoutHTMLDate}, false},
+		{"31-12-1990", []string{LayoutHTMLDate, LayoutDate}, true},
+		{"31.12.1990", []string{LocaleDeDE.DateLayout}, true},
+		{"12/31/1990", []string{LocaleEnUS.DateLayout}, true},
+		{"1990-12", []string{LayoutHTMLMonth}, true},
+		{"1990-13", []string{LayoutHTMLMonth}, false},
+		{"2004-W53", []string{LayoutHTMLWeek}, true},
+		{"2005-W53", []string{LayoutHTMLWeek}, false},
+		{"2005-W01", []string{LayoutHTMLWeek}, true},
+		{"2005-W00", []string{LayoutHTMLWeek}, false},
+		{"2005-W1", []string{LayoutHTMLWeek}, false},
+		{"2005W01", []string{LayoutHTMLWeek}, false},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IsDateLayout(l.layouts...)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("isDateLayout(%s, %v): Valid[%t]. Expected: %t", l.field, l.layouts, valid, l.expectation)
+		}
+	}
+}
+
+func Test_isTimeLayout(t *testing.T) {
+	var list = []struct {
+		field       string
+		layouts     []string
+		expectation bool
+	}{
+		{"14:23", []string{LayoutHTMLTime}, true},
+		{"14:23:56", []string{LayoutHTMLTime}, false},
+		{"14:23:56", []string{LayoutHTMLTime, LayoutHTMLTimeSeconds}, true},
+		{"14:23:56.250", []string{LayoutHTMLTime, LayoutHTMLTimeSeconds}, true},
+		{"24:00", []string{LayoutHTMLTime}, false},
+		{"2:23:56 PM", []string{LocaleEnUS.TimeLayout}, true},
+		{"14:23:56", []string{LocaleEnUS.TimeLayout}, false},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IsTimeLayout(l.layouts...)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("isTimeLayout(%s, %v): Valid[%t]. Expected: %t", l.field, l.layouts, valid, l.expectation)
+		}
+	}
+}
+
+func Test_isDateTimeLayout(t *testing.T) {
+	var list = []struct {
+		field       string
+		layouts     []string
+		expectation bool
+	}{
+		{"1990-12-31T14:23", []string{LayoutHTMLDateTimeLocal}, true},
+		{"1990-12-31 14:23", []string{LayoutHTMLDateTimeLocal}, false},
+		{"1990-12-31T14:23:56", []string{LayoutHTMLDateTimeLocal, LayoutHTMLDateTimeLocalSeconds}, true},
+		{"1990-12-31T14:23:56Z", []string{LayoutRFC3339}, true},
+		{"1990-12-31T14:23:56.123+02:00", []string{LayoutRFC3339}, true},
+		{"1990-12-31T14:23:56", []string{LayoutRFC3339}, false},
+		{"31.12.1990 14:23:56", []string{}, false}, // default: DD-MM-YYYY HH:MM:SS
+		{"31-12-1990 14:23:56", []string{}, true},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IsDateTimeLayout(l.layouts...)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("isDateTimeLayout(%s, %v): Valid[%t]. Expected: %t", l.field, l.layouts, valid, l.expectation)
+		}
+	}
+}
+
+func Test_dateLayoutMessage(t *testing.T) {
+	var list = []struct {
+		rule        Rule
+		expectation string
+	}{
+		{IsDateLayout(), "(DD-MM-YYYY) [Ex: 31-12-1990]"},
+		{IsTimeLayout(), "(HH:MM:SS) [Ex: 14:23:56]"},
+		{IsDateTimeLayout(), "(DD-MM-YYYY HH:MM:SS) [Ex: 31-12-1990 14:23:56]"},
+		{IsDateLayout(LayoutHTMLDate), "(YYYY-MM-DD) [Ex: 1990-12-31]"},
+		{IsDateLayout(LayoutHTMLWeek), "(YYYY-Www) [Ex: 1991-W01]"},
+		{IsDateLocale(LocaleDeDE), "(DD.MM.YYYY) [Ex: 31.12.1990]"},
+		{IsTimeLocale(LocaleEnUS), "(H:MM:SS AM/PM) [Ex: 2:23:56 PM]"},
+		{IsDateTimeLayout(LayoutRFC3339), "(YYYY-MM-DDTHH:MM:SS±HH:MM) [Ex: 1990-12-31T14:23:56Z]"},
+	}
+
+	messages := map[string]string{"date_layout": "(%s) [Ex: %s]", "time_layout": "(%s) [Ex: %s]", "date_time_layout": "(%s) [Ex: %s]"}
+	for _, l := range list {
+		err, data := l.rule.Validate([]string{"not a date"}, messages)
+		e := &FormError{Str: err.Error(), Data: data, Locale: LocaleEnUS}
+		if e.Error() != l.expectation {
+			t.Errorf("dateLayout message: Result[%s]. Expected: %s", e.Error(), l.expectation)
+		}
+	}
+
+	// the messages of the older rules have the format in them
+	for key, rule := range map[string]Rule{"date": IsDate(), "time": IsTime(), "date_time": IsDateTime()} {
+		if err, data := rule.Validate([]string{"not a date"}, testMessages); messageKey(err) != key || data != nil {
+			t.Errorf("dateLayout(%s): [%s, %v]. Expected: %s, no data", key, messageKey(err), data, key)
+		}
+	}
+}
+
+// a fixed clock: Wednesday 15-03-2017 10:30 UTC
+func testDateConfig(layouts ...string) *DateConfig {
+	return &DateConfig{
+		Layouts: layouts,
+		Now:     func() time.Time { return time.Date(2017, 3, 15, 10, 30, 0, 0, time.UTC) },
+	}
+}
+
+func Test_dateBetween(t *testing.T) {
+	min := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2000, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	var list = []struct {
+		field       string
+		min         time.Time
+		max         time.Time
+		expectation string
+	}{
+		{"", min, max, ""},
+		{"01-01-2000", min, max, ""},
+		{"31-12-2000", min, max, ""},
+		{"15-06-2000", min, max, ""},
+		{"31-12-1999", min, max, "date_min"},
+		{"01-01-2001", min, max, "date_max"},
+		{"01-01-1850", time.Time{}, max, ""},
+		{"01-01-2050", min, time.Time{}, ""},
+		{"2000-06-15", min, max, "date_layout"},
+	}
+
+	for _, l := range list {
+		err, _ := DateBetween(l.min, l.max, nil).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("dateBetween(%s): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+}
+
+func Test_dateWithinDays(t *testing.T) {
+	var list = []struct {
+		field       string
+		from        int
+		to          int
+		expectation string
+	}{
+		{"2017-03-15", 0, 30, ""},
+		{"2017-04-14", 0, 30, ""},
+		{"2017-04-15", 0, 30, "date_max"},
+		{"2017-03-14", 0, 30, "date_min"},
+		{"2017-03-08", -7, 0, ""},
+		{"2017-03-16", -7, 0, "date_max"},
+	}
+
+	for _, l := range list {
+		err, _ := DateWithinDays(l.from, l.to, testDateConfig(LayoutHTMLDate)).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("dateWithinDays(%s, %d, %d): Result[%s]. Expected: %s", l.field, l.from, l.to, result, l.expectation)
+		}
+	}
+
+	// the bounds are reported as dates
+	err, data := DateWithinDays(0, 30, testDateConfig(LayoutHTMLDate)).Validate([]string{"2017-05-01"}, testMessages)
+	e := &FormError{Str: "This date cannot be after %s.", Data: data, Locale: LocaleDeDE}
+	if err == nil || e.Error() != "This date cannot be after 14.04.2017." {
+		t.Errorf("dateWithinDays(): Unexpected error message! [%s]", e.Error())
+	}
+}
+
+func Test_notInFutureOrPast(t *testing.T) {
+	var list = []struct {
+		field  string
+		layout string
+		future string
+		past   string
+	}{
+		{"2017-03-15", LayoutHTMLDate, "", ""}, // today is neither
+		{"2017-03-16", LayoutHTMLDate, "date_future", ""},
+		{"2017-03-14", LayoutHTMLDate, "", "date_past"},
+		{"2017-03-15T10:29", LayoutHTMLDateTimeLocal, "", "date_past"},
+		{"2017-03-15T10:31", LayoutHTMLDateTimeLocal, "date_future", ""},
+		{"15-03-2017", LayoutHTMLDate, "date_layout", "date_layout"},
+		{"2017-03-15T10:31", LayoutHTMLDate, "date_layout", "date_layout"},
+		{"2017-03-15 10:31", LayoutHTMLDateTimeLocal, "date_time_layout", "date_time_layout"},
+		{"10:31", LayoutHTMLDateTimeLocal, "date_time_layout", "date_time_layout"},
+	}
+
+	for _, l := range list {
+		err, _ := NotInFuture(testDateConfig(l.layout)).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.future {
+			t.Errorf("notInFuture(%s): Result[%s]. Expected: %s", l.field, result, l.future)
+		}
+
+		err, _ = NotInPast(testDateConfig(l.layout)).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.past {
+			t.Errorf("notInPast(%s): Result[%s]. Expected: %s", l.field, result, l.past)
+		}
+	}
+}
+
+func Test_age(t *testing.T) {
+	var list = []struct {
+		field       string
+		rule        Rule
+		expectation string
+	}{
+		{"", MinAge(18, testDateConfig()), ""},
+		{"15-03-1999", MinAge(18, testDateConfig()), ""},
+		{"16-03-1999", MinAge(18, testDateConfig()), "min_age"},
+		{"01-01-2010", MinAge(18, testDateConfig()), "min_age"},
+		{"16-03-1916", MaxAge(100, testDateConfig()), ""},
+		{"15-03-1916", MaxAge(100, testDateConfig()), "max_age"},
+		{"1999-03-15", MinAge(18, testDateConfig()), "date_layout"},
+	}
+
+	for _, l := range list {
+		err, _ := l.rule.Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("age(%s): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+
+	leap := time.Date(2000, 2, 29, 0, 0, 0, 0, time.UTC)
+	if ageOn(leap, time.Date(2018, 2, 28, 0, 0, 0, 0, time.UTC)) != 17 || ageOn(leap, time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)) != 18 {
+		t.Errorf("ageOn(): A birthday on February 29th should be on March 1st in common years!")
+	}
+}
+
+func Test_timeOfDayBetween(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+
+	var list = []struct {
+		field       string
+		config      *DateConfig
+		expectation string
+	}{
+		{"09:00", testDateConfig(LayoutHTMLTime), ""},
+		{"17:00", testDateConfig(LayoutHTMLTime), ""},
+		{"12:30", testDateConfig(LayoutHTMLTime), ""},
+		{"08:59", testDateConfig(LayoutHTMLTime), "time_between"},
+		{"17:01", testDateConfig(LayoutHTMLTime), "time_between"},
+		{"9.00", testDateConfig(LayoutHTMLTime), "time_layout"},
+		{"2017-03-20T16:30", testDateConfig(LayoutHTMLDateTimeLocal), ""},
+		{"2017-03-20T16:30:00Z", testDateConfig(LayoutRFC3339), ""},
+		{"2017-03-20T16:30:00Z", &DateConfig{Layouts: []string{LayoutRFC3339}, Location: berlin}, "time_between"}, // 17:30 in Berlin
+	}
+
+	for _, l := range list {
+		err, _ := TimeOfDayBetween("09:00", "17:00", l.config).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("timeOfDayBetween(%s): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+
+	_, data := TimeOfDayBetween("09:00", "17:00", testDateConfig(LayoutHTMLTime)).Validate([]string{"18:00"}, testMessages)
+	e := &FormError{Str: "between %s and %s", Data: data, Locale: LocaleEnUS}
+	if e.Error() != "between 9:00:00 AM and 5:00:00 PM" {
+		t.Errorf("timeOfDayBetween(): Unexpected error message! [%s]", e.Error())
+	}
+
+	// bad bounds are found by New(), not replaced by the whole day
+	for _, bounds := range [][2]string{{"9am", "17:00"}, {"09:00", "25:00"}} {
+		r := TimeOfDayBetween(bounds[0], bounds[1], testDateConfig(LayoutHTMLTime))
+		if err := r.(Checker).Check(); err == nil {
+			t.Errorf("timeOfDayBetween(%s, %s): no error. Expected: a bad bound", bounds[0], bounds[1])
+		}
+
+		if err, _ := New(map[string][]Rule{"Time": {r}}); err == nil {
+			t.Errorf("New(TimeOfDayBetween(%s, %s)): no error. Expected: a bad bound", bounds[0], bounds[1])
+		}
+
+		if err, _ := r.Validate([]string{"12:00"}, testMessages); messageKey(err) != "time_between" {
+			t.Errorf("timeOfDayBetween(%s, %s): Result[%s]. Expected: time_between", bounds[0], bounds[1], messageKey(err))
+		}
+	}
+}
+
+func Test_onWeekdays(t *testing.T) {
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	var list = []struct {
+		field       string
+		expectation string
+	}{
+		{"2017-03-17", ""}, // Friday
+		{"2017-03-18", "weekday"},
+		{"2017-03-19", "weekday"},
+		{"2017-03-20", ""},
+	}
+
+	for _, l := range list {
+		err, _ := OnWeekdays(weekdays, testDateConfig(LayoutHTMLDate)).Validate([]string{l.field}, testMessages)
+		if result := messageKey(err); result != l.expectation {
+			t.Errorf("onWeekdays(%s): Result[%s]. Expected: %s", l.field, result, l.expectation)
+		}
+	}
+}
+
+func Test_dateRange(t *testing.T) {
+	var list = []struct {
+		start      string
+		end        string
+		startError string
+		endError   string
+	}{
+		{"", "", "", ""},
+		{"2017-03-01", "", "", ""},
+		{"2017-03-01", "2017-03-01", "", ""},
+		{"2017-03-01", "2017-05-30", "", ""},
+		{"2017-03-01", "2017-05-31", "", "date_range_max"},
+		{"2017-03-02", "2017-03-01", "", "date_range_order"},
+		{"01-03-2017", "2017-03-01", "date_layout", ""},
+		{"01-03-2017", "31-03-2017", "date_layout", "date_layout"},
+	}
+
+	for _, l := range list {
+		form := url.Values{}
+		form.Set("From", l.start)
+		form.Set("To", l.end)
+
+		errs := DateRange("From", "To", 0, 90, testDateConfig(LayoutHTMLDate)).ValidateGroup(form, testMessages)
+
+		startError, endError := "", ""
+		if len(errs["From"]) > 0 {
+			startError = errs["From"][0].Str
+		}
+		if len(errs["To"]) > 0 {
+			endError = errs["To"][0].Str
+		}
+
+		if startError != l.startError || endError != l.endError {
+			t.Errorf("dateRange(%s, %s): Result[%s, %s]. Expected: %s, %s", l.start, l.end, startError, endError, l.startError, l.endError)
+		}
+	}
+
+	// minimum length, date-times
+	form := url.Values{}
+	form.Set("From", "2017-03-01T10:00")
+	form.Set("To", "2017-03-02T09:00")
+	errs := DateRange("From", "To", 1, 0, testDateConfig(LayoutHTMLDateTimeLocal)).ValidateGroup(form, testMessages)
+	if len(errs["To"]) != 1 || errs["To"][0].Str != "date_range_min" || errs["To"][0].Data[0] != 1 {
+		t.Errorf("dateRange(): The range should be too short! %v", errs)
+	}
+}
@@ -0,0 +1,616 @@
+package formvalidator
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+// RFC 5321 limits, in octets
+const (
+	emailMaxLocal  = 64
+	emailMaxLength = 254
+)
+
+var ErrInvalidEmail = errors.New("String is not a valid e-mail address!")
+var ErrEmailTooLong = errors.New("E-mail address is longer than RFC 5321 allows!")
+
+// atext of RFC 5322, besides letters and digits
+const emailSpecials = "!#$%&'*+-/=?^_`{|}~"
+
+/*
+	Split an e-mail address into the local part and the domain, the domain is converted to lower case ASCII (punycode for IDNs)
+	"Bob@Bücher.Example" -> "Bob", "xn--bcher-kva.example"
+
+	ASCIILOCAL rejects UTF-8 in the local part (RFC 6531), QUOTED allows quoted local parts ("john doe"@example.com)
+	Length errors return ErrEmailTooLong, everything else ErrInvalidEmail
+*/
+func parseEmail(address string, asciiLocal, quoted bool) (string, string, error) {
+	at := strings.LastIndexByte(address, '@')
+	if at < 1 || at == len(address)-1 || !utf8.ValidString(address) {
+		return "", "", ErrInvalidEmail
+	}
+
+	local, domain := address[:at], address[at+1:]
+
+	if strings.HasPrefix(local, `"`) {
+		if !quoted || !isQuotedLocal(local) {
+			return "", "", ErrInvalidEmail
+		}
+	} else if !isDotAtom(local, asciiLocal) {
+		return "", "", ErrInvalidEmail
+	}
+
+	domain, err := ToASCIIDomain(domain)
+	if err != nil || strings.IndexByte(domain, '.') < 0 || !isHostname(domain) || strings.HasSuffix(domain, ".") {
+		return "", "", ErrInvalidEmail
+	}
+
+	if len(local) > emailMaxLocal || len(local)+1+len(domain) > emailMaxLength {
+		return "", "", ErrEmailTooLong
+	}
+
+	return local, domain, nil
+}
+
+// "john.doe", no leading, trailing or consecutive dots
+func isDotAtom(s string, asciiOnly bool) bool {
+	for _, atom := range strings.Split(s, ".") {
+		if len(atom) == 0 {
+			return false
+		}
+		for _, c := range atom {
+			switch {
+			case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', strings.ContainsRune(emailSpecials, c):
+			case c >= 0x80 && !asciiOnly:
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// "john doe", printable ASCII and spaces, backslash escapes a character
+func isQuotedLocal(s string) bool {
+	if len(s) < 2 || !strings.HasSuffix(s, `"`) {
+		return false
+	}
+
+	s = s[1 : len(s)-1]
+	for n := 0; n < len(s); n++ {
+		switch c := s[n]; {
+		case c == '\\':
+			if n++; n == len(s) || s[n] < 0x20 || s[n] > 0x7e {
+				return false
+			}
+		case c == '"', c < 0x20, c > 0x7e:
+			return false
+		}
+	}
+	return true
+}
+
+/*
+	Domain to lower case ASCII, labels with other characters are encoded with punycode (RFC 3492): "Bücher.example" -> "xn--bcher-kva.example"
+	Only lower casing is applied before encoding, not the full IDNA 2008 mapping, which needs Unicode tables the standard library does not have.
+*/
+func ToASCIIDomain(domain string) (string, error) {
+	labels := strings.Split(strings.ToLower(domain), ".")
+	for n, l := range labels {
+		if len(l) > 252 { // longer than any domain, even before encoding
+			return "", ErrInvalidEmail
+		}
+		if !isASCII(l) {
+			labels[n] = "xn--" + punycodeEncode(l)
+		}
+	}
+	return strings.Join(labels, "."), nil
+}
+
+func isASCII(s string) bool {
+	for n := 0; n < len(s); n++ {
+		if s[n] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// punycode parameters, RFC 3492 section 5
+const (
+	punyBase        = 36
+	punyTMin        = 1
+	punyTMax        = 26
+	punySkew        = 38
+	punyDamp        = 700
+	punyInitialBias = 72
+	punyInitialN    = 128
+)
+
+func punycodeAdapt(delta, numPoints int, first bool) int {
+	if first {
+		delta /= punyDamp
+	} else {
+		delta /= 2
+	}
+	delta += delta / numPoints
+
+	k := 0
+	for delta > ((punyBase-punyTMin)*punyTMax)/2 {
+		delta /= punyBase - punyTMin
+		k += punyBase
+	}
+	return k + (punyBase-punyTMin+1)*delta/(delta+punySkew)
+}
+
+func punycodeDigit(d int) byte {
+	if d < 26 {
+		return byte('a' + d)
+	}
+	return byte('0' + d - 26)
+}
+
+// encode a label, without the "xn--" prefix (RFC 3492 section 6.3)
+func punycodeEncode(label string) string {
+	input := []rune(label)
+	var output []byte
+
+	for _, c := range input {
+		if c < 0x80 {
+			output = append(output, byte(c))
+		}
+	}
+
+	b := len(output)
+	h := b
+	if b > 0 {
+		output = append(output, '-')
+	}
+
+	n, delta, bias := punyInitialN, 0, punyInitialBias
+	for h < len(input) {
+		// the smallest code point that is not handled yet
+		m := int(utf8.MaxRune) + 1
+		for _, c := range input {
+			if int(c) >= n && int(c) < m {
+				m = int(c)
+			}
+		}
+
+		delta += (m - n) * (h + 1)
+		n = m
+
+		for _, c := range input {
+			if int(c) < n {
+				delta++
+			}
+			if int(c) != n {
+				continue
+			}
+
+			q := delta
+			for k := punyBase; ; k += punyBase {
+				t := k - bias
+				if t < punyTMin {
+					t = punyTMin
+				} else if t > punyTMax {
+					t = punyTMax
+				}
+				if q < t {
+					break
+				}
+				output = append(output, punycodeDigit(t+(q-t)%(punyBase-t)))
+				q = (q - t) / (punyBase - t)
+			}
+			output = append(output, punycodeDigit(q))
+
+			bias = punycodeAdapt(delta, h+1, h == b)
+			delta = 0
+			h++
+		}
+
+		delta++
+		n++
+	}
+
+	return string(output)
+}
+
+/*
+	Mailbox providers that ignore parts of the local part, by domain
+	dots - "j.o.h.n" is "john"
+	tag - "john+newsletter" is "john"
+	domain - the canonical domain ("googlemail.com" is "gmail.com")
+*/
+type emailProvider struct {
+	dots   bool
+	tag    string
+	domain string
+}
+
+var emailProviders = map[string]emailProvider{
+	"gmail.com":      {true, "+", "gmail.com"},
+	"googlemail.com": {true, "+", "gmail.com"},
+	"outlook.com":    {false, "+", "outlook.com"},
+	"hotmail.com":    {false, "+", "hotmail.com"},
+	"live.com":       {false, "+", "live.com"},
+	"icloud.com":     {false, "+", "icloud.com"},
+	"me.com":         {false, "+", "me.com"},
+	"mac.com":        {false, "+", "mac.com"},
+	"fastmail.com":   {false, "+", "fastmail.com"},
+	"protonmail.com": {false, "+", "protonmail.com"},
+	"proton.me":      {false, "+", "proton.me"},
+	"yahoo.com":      {false, "-", "yahoo.com"},
+}
+
+/*
+	Canonical form of an e-mail address for finding duplicate accounts, not for sending mail
+	The domain is lower case ASCII, for the providers that ignore them, dots and +tags are removed from the local part
+	"J.O.H.N+news@GoogleMail.com" -> "john@gmail.com"
+	Local parts are case sensitive (RFC 5321), but every big provider ignores case, so they are lower cased.
+*/
+func CanonicalEmail(address string) (string, error) {
+	local, domain, err := parseEmail(strings.TrimSpace(address), false, true)
+	if err != nil {
+		return "", err
+	}
+
+	local = strings.ToLower(local)
+
+	if p, ok := emailProviders[domain]; ok {
+		if i := strings.Index(local, p.tag); i > 0 {
+			local = local[:i]
+		}
+		if p.dots {
+			local = strings.ReplaceAll(local, ".", "")
+		}
+		domain = p.domain
+	}
+
+	return local + "@" + domain, nil
+}
+
+// -----------------------
+
+/*
+	Options for EmailAddress()
+
+	ASCIILocal - reject UTF-8 in the local part, for mail servers without SMTPUTF8 (RFC 6531)
+	AllowQuoted - quoted local parts, "john doe"@example.com
+	Canonical - normalize to CanonicalEmail(), instead of only converting the domain
+	BlockDisposable - reject disposable domains and their subdomains, see EmailBlocklist and EmailAllowlist
+*/
+type EmailOptions struct {
+	ASCIILocal      bool
+	AllowQuoted     bool
+	Canonical       bool
+	BlockDisposable bool
+}
+
+type emailAddress struct {
+	options EmailOptions
+}
+
+/*
+	E-mail address, parsed instead of matched with a regular expression
+	The local part is at most 64 octets, the address at most 254 (RFC 5321)
+	Internationalized domains are converted to punycode and lower cased: "Bob@Bücher.Example" -> "Bob@xn--bcher-kva.example", see Normalizer
+	Addresses need a domain with a dot, domain literals ("bob@[192.0.2.1]") are not accepted.
+*/
+func EmailAddress(options EmailOptions) Rule {
+	return &emailAddress{options}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (e *emailAddress) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	_, domain, err := parseEmail(strings.TrimSpace(field), e.options.ASCIILocal, e.options.AllowQuoted)
+	if err == ErrEmailTooLong {
+		return errors.New(errorMessages["email_length"]), nil
+	}
+	if err != nil {
+		return errors.New(errorMessages["email"]), nil
+	}
+
+	if e.options.BlockDisposable {
+		if m, ok := matchDisposable(domain); ok {
+			return errors.New(errorMessages["email_disposable"]), []interface{}{m}
+		}
+	}
+
+	return nil, nil
+}
+
+func (e *emailAddress) Normalize(field string) string {
+	if e.options.Canonical {
+		if c, err := CanonicalEmail(field); err == nil {
+			return c
+		}
+		return field
+	}
+
+	local, domain, err := parseEmail(strings.TrimSpace(field), e.options.ASCIILocal, e.options.AllowQuoted)
+	if err != nil {
+		return field
+	}
+	return local + "@" + domain
+}
+
+// -----------------------
+
+/*
+	Defaults for EmailTypo(), popular mail providers and top level domains
+	A domain in the list is never reported as a typo.
+*/
+var (
+	EmailTypoDomains = []string{
+		"aol.com", "att.net", "comcast.net", "facebook.com", "gmail.com", "gmx.com", "gmx.de", "gmx.net", "googlemail.com",
+		"hotmail.co.uk", "hotmail.com", "hotmail.de", "hotmail.fr", "hotmail.it", "icloud.com", "live.com", "mac.com",
+		"mail.com", "mail.ru", "me.com", "msn.com", "outlook.com", "proton.me", "protonmail.com", "qq.com", "sbcglobal.net",
+		"t-online.de", "uol.com.br", "verizon.net", "web.de", "yahoo.co.uk", "yahoo.com", "yahoo.com.br", "yahoo.de",
+		"yahoo.fr", "yandex.ru", "ymail.com",
+	}
+	EmailTypoTLDs = []string{"com", "net", "org", "edu", "gov", "de", "fr", "uk", "co.uk", "com.br", "ru", "it", "es", "nl", "io"}
+)
+
+/*
+	The extra data of "email_typo" warnings
+	Prints as the suggested address, so messages can use "%s"
+*/
+type EmailSuggestion struct {
+	Address string // "bob@gmail.com"
+	Domain  string // "gmail.com"
+}
+
+func (s EmailSuggestion) String() string {
+	return s.Address
+}
+
+// second level labels of country domains, "co.uk", "com.br", "ne.jp"
+var secondLevelLabels = []string{"ac", "co", "com", "edu", "gov", "ne", "net", "or", "org"}
+
+/*
+	Split a domain at its public suffix: "mail.yahoo.co.uk" -> "mail.yahoo", "co.uk", "gmail.com" -> "gmail", "com"
+	The suffix is the last label, or the last two for second level domains of a country (see secondLevelLabels)
+*/
+func splitPublicSuffix(domain string) (string, string) {
+	dot := strings.LastIndexByte(domain, '.')
+	if dot < 1 {
+		return "", domain
+	}
+
+	if dot2 := strings.LastIndexByte(domain[:dot], '.'); dot2 > 0 && inSlice(secondLevelLabels, domain[dot2+1:dot]) {
+		dot = dot2
+	}
+	return domain[:dot], domain[dot+1:]
+}
+
+/*
+	A popular domain that DOMAIN is probably a typo of, "gmial.com" -> "gmail.com", "example.con" -> "example.com"
+	Only the name before the public suffix is compared, the entry keeps its country: "hotmial.es" -> "hotmail.es",
+	unless a known domain with that name has a suffix one edit away: "yaho.co" -> "yahoo.com"
+	Known names in another country ("yahoo.es", "hotmail.nl") and FreeEmailProviders are not typos.
+	Names within an edit distance of 2 (1 for short ones) are suggested, then a top level domain if the entry's is unknown
+*/
+func suggestEmailDomain(domain string, domains, tlds []string) (string, bool) {
+	if inSlice(domains, domain) || FreeEmailProviders.Contains(domain) {
+		return "", false
+	}
+
+	name, suffix := splitPublicSuffix(domain)
+	if len(name) == 0 {
+		return "", false
+	}
+
+	best, bestDistance := "", 3
+	for _, d := range domains {
+		dName, _ := splitPublicSuffix(d)
+		limit := 2
+		if len(dName) < 6 {
+			limit = 1
+		}
+
+		n := editDistance(name, dName)
+		if n == 0 { // the name is right
+			best = ""
+			break
+		}
+		if n <= limit && n < bestDistance {
+			best, bestDistance = dName, n
+		}
+	}
+
+	suggestion := domain
+	if len(best) > 0 {
+		suggestion = best + "." + suffix
+		for _, d := range domains {
+			if dName, dSuffix := splitPublicSuffix(d); dName == best && editDistance(suffix, dSuffix) == 1 && !inSlice(domains, suggestion) {
+				suggestion = d
+				break
+			}
+		}
+	}
+
+	if fixed, ok := fixTLD(suggestion, tlds); ok {
+		suggestion = fixed
+	}
+
+	return suggestion, suggestion != domain
+}
+
+// a top level domain from TLDS one edit away, when the domain's does not exist: "example.con" -> "example.com"
+func fixTLD(domain string, tlds []string) (string, bool) {
+	dot := strings.LastIndexByte(domain, '.')
+	if dot < 1 || TopLevelDomains.Contains(domain[dot+1:]) {
+		return "", false
+	}
+
+	for _, tld := range tlds {
+		// as many labels as the top level domain, "hotmail.co.ukk" -> "co.ukk" for "co.uk"
+		i := len(domain)
+		for n := strings.Count(tld, ".") + 1; n > 0 && i > 0; n-- {
+			i = strings.LastIndexByte(domain[:i], '.')
+		}
+		if i > 0 && editDistance(domain[i+1:], tld) == 1 {
+			return domain[:i+1] + tld, true
+		}
+	}
+
+	return "", false
+}
+
+type emailTypo struct {
+	domains []string
+	tlds    []string
+}
+
+/*
+	Suggests a correction for misspelled domains, "bob@gmial.com" -> "Did you mean bob@gmail.com?"
+	Returns a Warning, the form stays valid and the entry is kept, the extra data is an EmailSuggestion
+	DOMAINS and TLDS are the known good names, nil for EmailTypoDomains and EmailTypoTLDs
+
+	Ex: RuleChain(Email(true), EmailTypo(nil, nil))
+*/
+func EmailTypo(domains, tlds []string) Rule {
+	if domains == nil {
+		domains = EmailTypoDomains
+	}
+	if tlds == nil {
+		tlds = EmailTypoTLDs
+	}
+	return &emailTypo{domains, tlds}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (e *emailTypo) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	// the format is checked by Email() or EmailAddress()
+	local, domain, ok := splitEmail(strings.TrimSpace(field))
+	if !ok {
+		return nil, nil
+	}
+
+	if s, ok := suggestEmailDomain(strings.ToLower(domain), e.domains, e.tlds); ok {
+		return NewWarning(errorMessages["email_typo"]), []interface{}{EmailSuggestion{local + "@" + s, s}}
+	}
+
+	return nil, nil
+}
+
+// -----------------------
+
+/*
+	Options for EmailPolicy()
+
+	BlockRoleAccounts - reject shared addresses like admin@, noreply@, postmaster@ (the RoleAccounts dataset), "+tags" are ignored
+	BlockFreeMail - reject free mail providers like gmail.com (the FreeEmailProviders dataset), for "work e-mail" fields
+	AllowedDomains - if not empty, only these domains are accepted, for internal tools
+	DeniedDomains - domains that are rejected
+
+	Domain patterns: "example.com" - only that domain, "*.example.com" - its subdomains, not example.com itself, Unicode domains like "bücher.example" work too
+*/
+type EmailPolicyOptions struct {
+	BlockRoleAccounts bool
+	BlockFreeMail     bool
+	AllowedDomains    []string
+	DeniedDomains     []string
+}
+
+type emailPolicy struct {
+	options EmailPolicyOptions
+	allowed *DomainList
+	denied  *DomainList
+}
+
+/*
+	Which e-mail addresses a form accepts, the format is checked by Email() or EmailAddress()
+	Ex: EmailPolicy(EmailPolicyOptions{BlockRoleAccounts: true, BlockFreeMail: true}) for a "work e-mail" field
+	Ex: EmailPolicy(EmailPolicyOptions{AllowedDomains: []string{"example.com", "*.example.com"}}) for employees
+*/
+func EmailPolicy(options EmailPolicyOptions) Rule {
+	return &emailPolicy{options, NewDomainList("allowed", asciiDomainPatterns(options.AllowedDomains)...), NewDomainList("denied", asciiDomainPatterns(options.DeniedDomains)...)}
+}
+
+// patterns in the form the entries are matched in, "*.bücher.example" -> "*.xn--bcher-kva.example"
+func asciiDomainPatterns(patterns []string) []string {
+	list := make([]string, 0, len(patterns))
+	for _, p := range patterns {
+		p = strings.TrimSpace(p)
+		domain, wildcard := strings.CutPrefix(p, "*.")
+		if a, err := ToASCIIDomain(domain); err == nil {
+			p = a
+			if wildcard {
+				p = "*." + a
+			}
+		}
+		list = append(list, p)
+	}
+	return list
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (e *emailPolicy) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	local, domain, ok := splitEmail(strings.TrimSpace(field))
+	if !ok {
+		return errors.New(errorMessages["email"]), nil
+	}
+
+	domain, err := ToASCIIDomain(strings.TrimSuffix(domain, "."))
+	if err != nil {
+		return errors.New(errorMessages["email"]), nil
+	}
+
+	if _, ok := e.denied.Match(domain); ok {
+		return errors.New(errorMessages["email_domain"]), []interface{}{domain}
+	}
+
+	if _, ok := e.allowed.Match(domain); len(e.options.AllowedDomains) > 0 && !ok {
+		return errors.New(errorMessages["email_domain"]), []interface{}{domain}
+	}
+
+	if e.options.BlockFreeMail {
+		if _, ok := freeEmailList.Match(domain); ok {
+			return errors.New(errorMessages["email_free"]), []interface{}{domain}
+		}
+	}
+
+	if e.options.BlockRoleAccounts {
+		if tag := strings.IndexByte(local, '+'); tag > 0 {
+			local = local[:tag]
+		}
+		if local = strings.ToLower(local); RoleAccounts.Contains(local) {
+			return errors.New(errorMessages["email_role"]), []interface{}{local}
+		}
+	}
+
+	return nil, nil
+}
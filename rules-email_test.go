@@ -0,0 +1,248 @@
+package formvalidator
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func Test_punycodeEncode(t *testing.T) {
+	var list = []struct {
+		label    string
+		expected string
+	}{
+		{"bücher", "bcher-kva"},
+		{"münchen", "mnchen-3ya"},
+		{"中文网", "fiq228c5hs"},
+		{"ドメイン名例", "eckwd4c7cu47r2wf"},
+	}
+
+	for _, l := range list {
+		if r := punycodeEncode(l.label); r != l.expected {
+			t.Errorf("punycodeEncode(%s): [%s]. Expected: %s", l.label, r, l.expected)
+		}
+	}
+}
+
+func Test_emailAddress(t *testing.T) {
+	var list = []struct {
+		field      string
+		options    EmailOptions
+		key        string
+		normalized string
+	}{
+		{"", EmailOptions{}, "", ""},
+		{"bob@example.com", EmailOptions{}, "", "bob@example.com"},
+		{"Bob@Example.COM", EmailOptions{}, "", "Bob@example.com"},
+		{"bob@bücher.example", EmailOptions{}, "", "bob@xn--bcher-kva.example"},
+		{"文@中.中文网", EmailOptions{}, "", "文@xn--fiq.xn--fiq228c5hs"},
+		{"文@example.com", EmailOptions{ASCIILocal: true}, "email", ""},
+		{"a.b+tag@example.co.uk", EmailOptions{}, "", "a.b+tag@example.co.uk"},
+		{"o'brien@example.com", EmailOptions{}, "", "o'brien@example.com"},
+		{`"john doe"@example.com`, EmailOptions{}, "email", ""},
+		{`"john doe"@example.com`, EmailOptions{AllowQuoted: true}, "", `"john doe"@example.com`},
+		{`"john\"doe"@example.com`, EmailOptions{AllowQuoted: true}, "", `"john\"doe"@example.com`},
+		{`"john"doe"@example.com`, EmailOptions{AllowQuoted: true}, "email", ""},
+		{"J.O.H.N+news@GoogleMail.com", EmailOptions{Canonical: true}, "", "john@gmail.com"},
+		{strings.Repeat("a", 64) + "@example.com", EmailOptions{}, "", strings.Repeat("a", 64) + "@example.com"},
+		{strings.Repeat("a", 65) + "@example.com", EmailOptions{}, "email_length", ""},
+		{"bob@" + strings.Repeat("a", 63) + "." + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 57) + ".com", EmailOptions{}, "email_length", ""},
+		{"bob@" + strings.Repeat("a", 64) + ".com", EmailOptions{}, "email", ""},
+		{"bob", EmailOptions{}, "email", ""},
+		{"@example.com", EmailOptions{}, "email", ""},
+		{"bob@", EmailOptions{}, "email", ""},
+		{"bob@localhost", EmailOptions{}, "email", ""},
+		{"bob@example.com.", EmailOptions{}, "email", ""},
+		{"bob@-example.com", EmailOptions{}, "email", ""},
+		{"bob@exa_mple.com", EmailOptions{}, "email", ""},
+		{"bob@[192.0.2.1]", EmailOptions{}, "email", ""},
+		{".bob@example.com", EmailOptions{}, "email", ""},
+		{"bob.@example.com", EmailOptions{}, "email", ""},
+		{"bo..b@example.com", EmailOptions{}, "email", ""},
+		{"bo b@example.com", EmailOptions{}, "email", ""},
+		{"bob@@example.com", EmailOptions{}, "email", ""},
+		{"bob@example.123", EmailOptions{}, "email", ""},
+		{"bob\xff@example.com", EmailOptions{}, "email", ""},
+	}
+
+	for _, l := range list {
+		var rule Rule = EmailAddress(l.options)
+
+		e, _ := rule.Validate([]string{l.field}, testMessages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("emailAddress(%s): Error[%s]. Expected: %s", l.field, key, l.key)
+		}
+
+		if e == nil && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("emailAddress(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_canonicalEmail(t *testing.T) {
+	var list = []struct {
+		address  string
+		expected string
+	}{
+		{"John.Doe+signup@gmail.com", "johndoe@gmail.com"},
+		{"j.o.h.n.d.o.e@googlemail.com", "johndoe@gmail.com"},
+		{"john.doe+news@outlook.com", "john.doe@outlook.com"},
+		{"john.doe-news@yahoo.com", "john.doe@yahoo.com"},
+		{"John.Doe+news@Example.com", "john.doe+news@example.com"}, // unknown provider
+		{"+tag@gmail.com", "+tag@gmail.com"},
+		{"bob@Bücher.example", "bob@xn--bcher-kva.example"},
+	}
+
+	for _, l := range list {
+		if r, err := CanonicalEmail(l.address); err != nil || r != l.expected {
+			t.Errorf("CanonicalEmail(%s): [%s, %v]. Expected: %s", l.address, r, err, l.expected)
+		}
+	}
+
+	if _, err := CanonicalEmail("not an address"); err != ErrInvalidEmail {
+		t.Errorf("CanonicalEmail(not an address): [%v]. Expected: %v", err, ErrInvalidEmail)
+	}
+}
+
+func Test_emailTypo(t *testing.T) {
+	var list = []struct {
+		field      string
+		suggestion string
+	}{
+		{"", ""},
+		{"bob@gmail.com", ""},
+		{"bob@gmial.com", "bob@gmail.com"},
+		{"bob@GMIAL.com", "bob@gmail.com"},
+		{"bob@hotmial.com", "bob@hotmail.com"},
+		{"bob@yaho.co", "bob@yahoo.com"},
+		{"bob@outlok.com", "bob@outlook.com"},
+		{"bob@example.con", "bob@example.com"},
+		{"bob@example.nte", "bob@example.net"},
+		{"bob@example.co.ukk", "bob@example.co.uk"},
+		{"bob@example.com", ""},
+		{"bob@example.cm", ""}, // Cameroon
+		{"bob@gmx.at", ""},
+		{"bob@yahoo.es", ""}, // other countries of known providers
+		{"bob@yahoo.it", ""},
+		{"bob@yahoo.in", ""},
+		{"bob@yahoo.ca", ""},
+		{"bob@yahoo.co.jp", ""},
+		{"bob@hotmail.es", ""},
+		{"bob@hotmail.nl", ""},
+		{"bob@hotmail.be", ""},
+		{"bob@hotmial.es", "bob@hotmail.es"},
+		{"bob@gmial.con", "bob@gmail.com"},
+		{"bob@yahooo.co.uk", "bob@yahoo.co.uk"},
+		{"bob@company.example", ""},
+		{"not an address", ""},
+	}
+
+	for _, l := range list {
+		e, data := EmailTypo(nil, nil).Validate([]string{l.field}, testMessages)
+		if len(l.suggestion) == 0 {
+			if e != nil {
+				t.Errorf("emailTypo(%s): Error[%s] %v. Expected: none", l.field, messageKey(e), data)
+			}
+			continue
+		}
+
+		if !isWarning(e) || messageKey(e) != "email_typo" {
+			t.Errorf("emailTypo(%s): Error[%v]. Expected: email_typo warning", l.field, e)
+			continue
+		}
+
+		if len(data) != 1 || data[0].(EmailSuggestion).String() != l.suggestion {
+			t.Errorf("emailTypo(%s): Data[%v]. Expected: %s", l.field, data, l.suggestion)
+		}
+	}
+
+	// custom list
+	if _, data := EmailTypo([]string{"acme-corp.com"}, []string{}).Validate([]string{"bob@acme-crop.com"}, testMessages); len(data) != 1 || data[0].(EmailSuggestion).Domain != "acme-corp.com" {
+		t.Errorf("emailTypo(custom list): Data[%v]. Expected: acme-corp.com", data)
+	}
+}
+
+func Test_editDistance(t *testing.T) {
+	var list = []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"gmail.com", "gmail.com", 0},
+		{"gmial.com", "gmail.com", 1},
+		{"gmai.com", "gmail.com", 1},
+		{"yaho.co", "yahoo.com", 2},
+		{"kitten", "sitting", 3},
+		{"", "abc", 3},
+	}
+
+	for _, l := range list {
+		if n := editDistance(l.a, l.b); n != l.expected {
+			t.Errorf("editDistance(%s, %s): %d. Expected: %d", l.a, l.b, n, l.expected)
+		}
+	}
+}
+
+func Test_emailPolicy(t *testing.T) {
+	var list = []struct {
+		field   string
+		options EmailPolicyOptions
+		key     string
+		data    string
+	}{
+		{"", EmailPolicyOptions{BlockRoleAccounts: true}, "", ""},
+		{"admin@example.com", EmailPolicyOptions{}, "", ""},
+		{"admin@example.com", EmailPolicyOptions{BlockRoleAccounts: true}, "email_role", "admin"},
+		{"NoReply+billing@example.com", EmailPolicyOptions{BlockRoleAccounts: true}, "email_role", "noreply"},
+		{"postmaster@example.com", EmailPolicyOptions{BlockRoleAccounts: true}, "email_role", "postmaster"},
+		{"administrator.bob@example.com", EmailPolicyOptions{BlockRoleAccounts: true}, "", ""},
+		{"bob@gmail.com", EmailPolicyOptions{BlockFreeMail: true}, "email_free", "gmail.com"},
+		{"bob@GMail.com", EmailPolicyOptions{BlockFreeMail: true}, "email_free", "gmail.com"},
+		{"bob@gmail.com", EmailPolicyOptions{}, "", ""},
+		{"bob@example.com", EmailPolicyOptions{BlockFreeMail: true}, "", ""},
+		{"bob@example.com", EmailPolicyOptions{AllowedDomains: []string{"example.com", "*.example.com"}}, "", ""},
+		{"bob@eu.example.com", EmailPolicyOptions{AllowedDomains: []string{"example.com", "*.example.com"}}, "", ""},
+		{"bob@example.org", EmailPolicyOptions{AllowedDomains: []string{"example.com", "*.example.com"}}, "email_domain", "example.org"},
+		{"bob@eu.example.com", EmailPolicyOptions{AllowedDomains: []string{"example.com"}}, "email_domain", "eu.example.com"},
+		{"bob@competitor.example", EmailPolicyOptions{DeniedDomains: []string{"competitor.example"}}, "email_domain", "competitor.example"},
+		{"bob@mail.competitor.example", EmailPolicyOptions{DeniedDomains: []string{"competitor.example"}}, "", ""},
+		{"bob@bücher.example", EmailPolicyOptions{DeniedDomains: []string{"xn--bcher-kva.example"}}, "email_domain", "xn--bcher-kva.example"},
+		{"bob@bücher.example", EmailPolicyOptions{DeniedDomains: []string{"Bücher.example"}}, "email_domain", "xn--bcher-kva.example"},
+		{"bob@xn--bcher-kva.example", EmailPolicyOptions{AllowedDomains: []string{"bücher.example"}}, "", ""},
+		{"bob@eu.bücher.example", EmailPolicyOptions{AllowedDomains: []string{"*.bücher.example"}}, "", ""},
+		{"bob@bücher.example", EmailPolicyOptions{AllowedDomains: []string{"*.bücher.example"}}, "email_domain", "xn--bcher-kva.example"},
+		{"bob", EmailPolicyOptions{}, "email", ""},
+	}
+
+	for _, l := range list {
+		e, data := EmailPolicy(l.options).Validate([]string{l.field}, testMessages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("emailPolicy(%s, %+v): Error[%s]. Expected: %s", l.field, l.options, key, l.key)
+		}
+
+		if len(l.data) > 0 && (len(data) != 1 || data[0] != l.data) {
+			t.Errorf("emailPolicy(%s, %+v): Data[%v]. Expected: %s", l.field, l.options, data, l.data)
+		}
+	}
+
+	// the default messages use the data
+	var messages = []struct {
+		field    string
+		options  EmailPolicyOptions
+		expected string
+	}{
+		{"bob@gmail.com", EmailPolicyOptions{BlockFreeMail: true}, "Please use your work e-mail address, not one from gmail.com."},
+		{"admin@example.com", EmailPolicyOptions{BlockRoleAccounts: true}, "Please use a personal e-mail address, not a shared one like admin@."},
+		{"bob@competitor.example", EmailPolicyOptions{DeniedDomains: []string{"competitor.example"}}, "E-mail addresses from competitor.example are not allowed."},
+	}
+
+	for _, m := range messages {
+		_, validator := New(map[string][]Rule{"Email": {EmailPolicy(m.options)}})
+		_, errors := validator.Validate(url.Values{"Email": {m.field}})
+		if len(errors["Email"]) != 1 || errors["Email"][0].Error() != m.expected {
+			t.Errorf("emailPolicy(%s): %v. Expected: %s", m.field, errors["Email"], m.expected)
+		}
+	}
+}
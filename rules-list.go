@@ -7,14 +7,11 @@ import (
 )
 
 type inListSingle struct {
-	list []string
+	list stringSet
 }
 
 func InListSingle(list []string) Rule {
-	if list == nil {
-		list = []string{}
-	}
-	return &inListSingle{list}
+	return &inListSingle{newStringSet(list)}
 }
 
 /*
@@ -36,7 +33,7 @@ func (i *inListSingle) Validate(fields []string, errorMessages map[string]string
 	}
 
 	// Is the submitted entry in the allowed list?
-	if inSlice(i.list, fields[0]) {
+	if i.list.has(fields[0]) {
 		return nil, nil
 	}
 
@@ -46,14 +43,11 @@ func (i *inListSingle) Validate(fields []string, errorMessages map[string]string
 // -----------------------
 
 type inListMultiple struct {
-	list []string
+	list stringSet
 }
 
 func InListMultiple(list []string) Rule {
-	if list == nil {
-		list = []string{}
-	}
-	return &inListMultiple{list}
+	return &inListMultiple{newStringSet(list)}
 }
 
 /*
@@ -76,24 +70,23 @@ func (i *inListMultiple) Validate(fields []string, errorMessages map[string]stri
 		return errors.New(errorMessages["duplicate"]), nil
 	}
 
-	if diff := sliceDiff(fields, i.list); diff == nil {
-		return nil, nil
+	for _, f := range fields {
+		if !i.list.has(f) {
+			return errors.New(errorMessages["in_list"]), nil
+		}
 	}
 
-	return errors.New(errorMessages["in_list"]), nil
+	return nil, nil
 }
 
 // -----------------------
 
 type notInListSingle struct {
-	list []string
+	list stringSet
 }
 
 func NotInListSingle(list []string) Rule {
-	if list == nil {
-		list = []string{}
-	}
-	return &notInListSingle{list}
+	return &notInListSingle{newStringSet(list)}
 }
 
 /*
@@ -112,7 +105,7 @@ func (i *notInListSingle) Validate(fields []string, errorMessages map[string]str
 	}
 
 	// Is the submitted entry in the allowed list?
-	if !inSlice(i.list, field) {
+	if !i.list.has(field) {
 		return nil, nil
 	}
 
@@ -142,7 +135,7 @@ func (c *countryCode) Validate(fields []string, errorMessages map[string]string)
 	}
 
 	// check the list
-	if inSlice(iso3166countryCodes, field) {
+	if CountryCodes.Contains(field) {
 		return nil, nil
 	}
 
@@ -235,7 +228,7 @@ func (n *notCommonPassword) Validate(fields []string, errorMessages map[string]s
 	}
 
 	// Is the submitted entry in the allowed list?
-	if !inSlice(commonPasswordList, field) {
+	if !CommonPasswords.Contains(field) {
 		return nil, nil
 	}
 
@@ -265,7 +258,7 @@ func (c *currencyCode) Validate(fields []string, errorMessages map[string]string
 	}
 
 	// check the list
-	if inSlice(iso4217currencyCodes, field) {
+	if CurrencyCodes.Contains(field) {
 		return nil, nil
 	}
 
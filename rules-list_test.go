@@ -316,6 +316,8 @@ func Test_currencyCode(t *testing.T) {
 		{"abc", false},
 		{"cad", true},
 		{"dkk", true},
+		{"ves", true},
+		{"vef", true}, // withdrawn in 2018, still accepted
 	}
 	for _, l := range list {
 		valid := false
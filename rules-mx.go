@@ -0,0 +1,273 @@
+package formvalidator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+	Looks up the mail servers and addresses of a domain, *net.Resolver implements it: EmailDeliverable(net.DefaultResolver)
+	Wrap it in NewCachingResolver() for per lookup timeouts and caching, see StaticMXResolver for tests
+*/
+type MXResolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	Resolver
+}
+
+/*
+	An MXResolver that answers from maps, for tests and offline use
+	MX - domain -> mail servers, Hosts - host name -> addresses (see StaticResolver)
+	Unknown names return a "not found" *net.DNSError, {Host: "."} is a null MX (RFC 7505)
+*/
+type StaticMXResolver struct {
+	MX    map[string][]*net.MX
+	Hosts StaticResolver
+}
+
+func (s StaticMXResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	mx, ok := s.MX[strings.ToLower(strings.TrimSuffix(name, "."))]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+	}
+	return mx, nil
+}
+
+func (s StaticMXResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	return s.Hosts.LookupNetIP(ctx, network, host)
+}
+
+type cachedLookup struct {
+	mx      []*net.MX
+	addrs   []netip.Addr
+	err     error
+	expires time.Time
+}
+
+/*
+	An MXResolver that bounds every lookup with TIMEOUT and remembers the answers for TTL
+	Temporary errors (timeouts, unreachable servers) are not cached, "not found" answers are.
+	Concurrent lookups of the same name wait for one answer, the cache holds up to 10000 answers (see SetMaxEntries).
+	Safe for concurrent use, share one between requests:
+
+	var mailResolver = fv.NewCachingResolver(net.DefaultResolver, 10*time.Minute, 2*time.Second)
+*/
+type CachingResolver struct {
+	resolver MXResolver
+	ttl      time.Duration
+	timeout  time.Duration
+	now      func() time.Time
+
+	mu         sync.Mutex
+	cache      map[string]cachedLookup
+	maxEntries int
+	pending    map[string]*pendingLookup
+}
+
+// a lookup in progress, result is set before done is closed
+type pendingLookup struct {
+	done   chan struct{}
+	result cachedLookup
+}
+
+// default for CachingResolver.SetMaxEntries()
+const cachingResolverMaxEntries = 10000
+
+func NewCachingResolver(r MXResolver, ttl, timeout time.Duration) *CachingResolver {
+	return &CachingResolver{resolver: r, ttl: ttl, timeout: timeout, now: time.Now, cache: make(map[string]cachedLookup), maxEntries: cachingResolverMaxEntries, pending: make(map[string]*pendingLookup)}
+}
+
+/*
+	How many answers the cache holds, 0 for no limit
+	When it is full, a random answer is removed for each new one, entries typed into a form should not grow it forever.
+*/
+func (c *CachingResolver) SetMaxEntries(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxEntries = n
+	for key := range c.cache {
+		if n <= 0 || len(c.cache) <= n {
+			break
+		}
+		delete(c.cache, key)
+	}
+}
+
+func (c *CachingResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	l := c.lookup(ctx, "mx "+strings.ToLower(name), func(ctx context.Context) (l cachedLookup) {
+		l.mx, l.err = c.resolver.LookupMX(ctx, name)
+		return l
+	})
+	return l.mx, l.err
+}
+
+func (c *CachingResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	l := c.lookup(ctx, network+" "+strings.ToLower(host), func(ctx context.Context) (l cachedLookup) {
+		l.addrs, l.err = c.resolver.LookupNetIP(ctx, network, host)
+		return l
+	})
+	return l.addrs, l.err
+}
+
+func (c *CachingResolver) lookup(ctx context.Context, key string, fn func(context.Context) cachedLookup) cachedLookup {
+	c.mu.Lock()
+	if l, ok := c.cache[key]; ok {
+		if c.now().Before(l.expires) {
+			c.mu.Unlock()
+			return l
+		}
+		delete(c.cache, key)
+	}
+
+	// another request is looking up the same name
+	if p, ok := c.pending[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-p.done:
+			return p.result
+		case <-ctx.Done():
+			return cachedLookup{err: ctx.Err()}
+		}
+	}
+
+	p := &pendingLookup{done: make(chan struct{})}
+	c.pending[key] = p
+	c.mu.Unlock()
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	l := fn(ctx)
+
+	c.mu.Lock()
+	delete(c.pending, key)
+	if !isTemporaryDNSError(l.err) {
+		l.expires = c.now().Add(c.ttl)
+		c.store(key, l)
+	}
+	c.mu.Unlock()
+
+	p.result = l
+	close(p.done)
+	return l
+}
+
+// called with the lock held, removes a random answer if the cache is full (map iteration order is random)
+func (c *CachingResolver) store(key string, l cachedLookup) {
+	if _, ok := c.cache[key]; !ok && c.maxEntries > 0 && len(c.cache) >= c.maxEntries {
+		for k := range c.cache {
+			delete(c.cache, k)
+			break
+		}
+	}
+	c.cache[key] = l
+}
+
+// timeouts and server failures, the answer may be different on the next try
+func isTemporaryDNSError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return !dnsErr.IsNotFound
+	}
+	return true
+}
+
+var ErrNullMX = errors.New("Domain does not accept e-mail! (null MX)")
+var ErrNoMailServer = errors.New("Domain has no mail server!")
+
+/*
+	Can the domain receive e-mail? (RFC 5321, section 5.1)
+	Uses the MX records, or the A/AAAA records of the domain itself if it has no MX records
+	A null MX record ("." RFC 7505) returns ErrNullMX, a domain without MX or addresses ErrNoMailServer
+	Other errors come from the resolver, like timeouts
+*/
+func lookupMailDomain(ctx context.Context, r MXResolver, domain string) error {
+	mx, err := r.LookupMX(ctx, domain)
+	if err != nil && isTemporaryDNSError(err) {
+		return err
+	}
+
+	for _, m := range mx {
+		if m.Host == "." || len(m.Host) == 0 {
+			if len(mx) == 1 {
+				return ErrNullMX
+			}
+			continue
+		}
+		return nil
+	}
+
+	addrs, err := r.LookupNetIP(ctx, "ip", domain)
+	if err != nil && isTemporaryDNSError(err) {
+		return err
+	}
+	if len(addrs) == 0 {
+		return ErrNoMailServer
+	}
+	return nil
+}
+
+// overall limit for one entry, use NewCachingResolver() for a shorter limit per lookup
+const emailDeliverableTimeout = 5 * time.Second
+
+type emailDeliverable struct {
+	resolver MXResolver
+}
+
+/*
+	E-mail address with a domain that can receive mail, the domain must have MX records, or A/AAAA records without any MX records
+	Domains that publish a null MX (RFC 7505) are rejected, for example "bob@example.com"
+
+	DNS failures and timeouts do not reject the entry, a signup should not fail because a name server is slow.
+	The address is only checked for an '@', use it after Email() or EmailAddress(), which do not need the network.
+
+	Ex: EmailDeliverable(NewCachingResolver(net.DefaultResolver, 10*time.Minute, 2*time.Second))
+*/
+func EmailDeliverable(resolver MXResolver) Rule {
+	return &emailDeliverable{resolver}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (e *emailDeliverable) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	_, domain, ok := splitEmail(strings.TrimSpace(field))
+	if !ok {
+		return errors.New(errorMessages["email"]), nil
+	}
+
+	domain, err := ToASCIIDomain(domain)
+	if err != nil || !isHostname(domain) {
+		return errors.New(errorMessages["email"]), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), emailDeliverableTimeout)
+	defer cancel()
+
+	switch err := lookupMailDomain(ctx, e.resolver, domain); err {
+	case ErrNullMX, ErrNoMailServer:
+		return errors.New(errorMessages["email_deliverable"]), []interface{}{domain}
+	}
+
+	return nil, nil
+}
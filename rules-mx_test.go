@@ -0,0 +1,210 @@
+package formvalidator
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testMXResolver = StaticMXResolver{
+	MX: map[string][]*net.MX{
+		"example.org":    {{Host: "mail.example.org.", Pref: 10}},
+		"null.example":   {{Host: ".", Pref: 0}},
+		"backup.example": {{Host: ".", Pref: 0}, {Host: "mx.backup.example.", Pref: 20}},
+		"empty.example":  {},
+	},
+	Hosts: StaticResolver{
+		"mail.example.org": {netip.MustParseAddr("192.0.2.25")},
+		"a-only.example":   {netip.MustParseAddr("192.0.2.26")},
+	},
+}
+
+// returns a temporary error for every lookup, like a name server that does not answer
+type failingResolver struct {
+	calls int
+}
+
+func (f *failingResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	f.calls++
+	return nil, &net.DNSError{Err: "i/o timeout", Name: name, IsTimeout: true}
+}
+
+func (f *failingResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	f.calls++
+	return nil, &net.DNSError{Err: "i/o timeout", Name: host, IsTimeout: true}
+}
+
+func Test_emailDeliverable(t *testing.T) {
+	var list = []struct {
+		field  string
+		key    string
+		domain string
+	}{
+		{"", "", ""},
+		{"bob@example.org", "", ""},
+		{"bob@EXAMPLE.org", "", ""},
+		{"bob@a-only.example", "", ""}, // no MX, A record fallback
+		{"bob@backup.example", "", ""}, // "." with other MX records is not a null MX
+		{"bob@null.example", "email_deliverable", "null.example"},
+		{"bob@empty.example", "email_deliverable", "empty.example"},
+		{"bob@nowhere.example", "email_deliverable", "nowhere.example"},
+		{"bob@bücher.example", "email_deliverable", "xn--bcher-kva.example"},
+		{"bob", "email", ""},
+		{"bob@bad_domain.example", "email", ""},
+	}
+
+	for _, l := range list {
+		e, data := EmailDeliverable(testMXResolver).Validate([]string{l.field}, testMessages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("emailDeliverable(%s): Error[%s]. Expected: %s", l.field, key, l.key)
+		}
+
+		if len(l.domain) > 0 && (len(data) != 1 || data[0] != l.domain) {
+			t.Errorf("emailDeliverable(%s): Data[%v]. Expected: %s", l.field, data, l.domain)
+		}
+	}
+
+	// DNS failures do not reject the entry
+	if e, _ := EmailDeliverable(&failingResolver{}).Validate([]string{"bob@example.org"}, testMessages); e != nil {
+		t.Errorf("emailDeliverable(timeout): Error[%s]. Expected: none", messageKey(e))
+	}
+}
+
+// counts the lookups that reach the wrapped resolver
+type countingResolver struct {
+	MXResolver
+	calls int
+}
+
+func (c *countingResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	c.calls++
+	return c.MXResolver.LookupMX(ctx, name)
+}
+
+func Test_cachingResolver(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r := &countingResolver{MXResolver: testMXResolver}
+	c := NewCachingResolver(r, time.Minute, time.Second)
+	c.now = func() time.Time { return now }
+
+	var list = []struct {
+		name    string
+		advance time.Duration
+		calls   int
+	}{
+		{"example.org", 0, 1},
+		{"EXAMPLE.org", 0, 1},
+		{"nowhere.example", 0, 2}, // "not found" is cached too
+		{"nowhere.example", 30 * time.Second, 2},
+		{"example.org", time.Minute, 3}, // expired
+	}
+
+	for _, l := range list {
+		now = now.Add(l.advance)
+		c.LookupMX(context.Background(), l.name)
+		if r.calls != l.calls {
+			t.Errorf("CachingResolver.LookupMX(%s): %d lookups. Expected: %d", l.name, r.calls, l.calls)
+		}
+	}
+
+	if mx, err := c.LookupMX(context.Background(), "example.org"); err != nil || len(mx) != 1 || mx[0].Host != "mail.example.org." {
+		t.Errorf("CachingResolver.LookupMX(example.org): [%v, %v]. Expected: mail.example.org.", mx, err)
+	}
+
+	f := &failingResolver{}
+	c = NewCachingResolver(f, time.Minute, time.Second)
+	c.LookupMX(context.Background(), "example.org")
+	c.LookupMX(context.Background(), "example.org")
+	if f.calls != 2 {
+		t.Errorf("CachingResolver: %d lookups. Expected: 2, temporary errors are not cached", f.calls)
+	}
+}
+
+func Test_cachingResolverLimits(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	c := NewCachingResolver(testMXResolver, time.Minute, time.Second)
+	c.now = func() time.Time { return now }
+	c.SetMaxEntries(2)
+
+	for _, name := range []string{"example.org", "null.example", "backup.example", "empty.example"} {
+		c.LookupMX(context.Background(), name)
+		if len(c.cache) > 2 {
+			t.Errorf("CachingResolver.LookupMX(%s): %d answers. Expected: 2 at most", name, len(c.cache))
+		}
+	}
+
+	// an expired answer is removed when it is looked up
+	c.SetMaxEntries(0)
+	c.LookupMX(context.Background(), "nowhere.example")
+	now = now.Add(time.Hour)
+	c.cache["mx nowhere.example"] = cachedLookup{err: errors.New("stale"), expires: now}
+	if _, err := c.LookupMX(context.Background(), "nowhere.example"); err == nil || err.Error() == "stale" {
+		t.Errorf("CachingResolver.LookupMX(nowhere.example): Error[%v]. Expected: not found, the stale answer is expired", err)
+	}
+}
+
+// blocks every lookup until release is closed
+type blockingResolver struct {
+	MXResolver
+	calls   atomic.Int32
+	started chan struct{}
+	release chan struct{}
+}
+
+func (b *blockingResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	if b.calls.Add(1) == 1 {
+		close(b.started)
+	}
+	<-b.release
+	return b.MXResolver.LookupMX(ctx, name)
+}
+
+func Test_cachingResolverConcurrent(t *testing.T) {
+	r := &blockingResolver{MXResolver: testMXResolver, started: make(chan struct{}), release: make(chan struct{})}
+	c := NewCachingResolver(r, time.Minute, time.Second)
+
+	var wg sync.WaitGroup
+	lookup := func() {
+		defer wg.Done()
+		if mx, err := c.LookupMX(context.Background(), "example.org"); err != nil || len(mx) != 1 {
+			t.Errorf("CachingResolver.LookupMX(example.org): [%v, %v]. Expected: mail.example.org.", mx, err)
+		}
+	}
+
+	wg.Add(1)
+	go lookup()
+	<-r.started
+
+	for n := 0; n < 10; n++ {
+		wg.Add(1)
+		go lookup()
+	}
+	close(r.release)
+	wg.Wait()
+
+	if calls := r.calls.Load(); calls != 1 {
+		t.Errorf("CachingResolver: %d lookups. Expected: 1, concurrent lookups of a name share the answer", calls)
+	}
+
+	// a waiting request gives up with its own context
+	r = &blockingResolver{MXResolver: testMXResolver, started: make(chan struct{}), release: make(chan struct{})}
+	c = NewCachingResolver(r, time.Minute, time.Second)
+	wg.Add(1)
+	go lookup()
+	<-r.started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.LookupMX(ctx, "example.org"); !errors.Is(err, context.Canceled) {
+		t.Errorf("CachingResolver.LookupMX(canceled): Error[%v]. Expected: %v", err, context.Canceled)
+	}
+	close(r.release)
+	wg.Wait()
+}
@@ -0,0 +1,449 @@
+package formvalidator
+
+import (
+	"errors"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// address family for IP(), CIDR() and PublicIP()
+type IPFamily int
+
+const (
+	IPAny IPFamily = iota
+	IPv4
+	IPv6
+)
+
+// parse an IP address of the family, zones ("fe80::1%eth0") are not accepted
+func parseIP(s string, family IPFamily) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil || addr.Zone() != "" {
+		return addr, false
+	}
+
+	switch family {
+	case IPv4:
+		return addr, addr.Is4()
+	case IPv6:
+		return addr, addr.Is6()
+	}
+	return addr, true
+}
+
+/*
+	The IPv4 forms that inet_aton() accepts besides dotted decimal, libc resolvers and curl connect to them:
+	"2130706433", "0x7f000001", "0177.0.0.1", "127.1" are all 127.0.0.1
+	1 to 4 parts, each decimal, octal (leading 0) or hex (0x), the last part fills the remaining bytes
+*/
+func parseLegacyIPv4(s string) (netip.Addr, bool) {
+	parts := strings.Split(strings.TrimSuffix(s, "."), ".")
+	if len(parts) > 4 {
+		return netip.Addr{}, false
+	}
+
+	var ip uint64
+	for n, p := range parts {
+		base := 10
+		switch {
+		case len(p) > 1 && (p[:2] == "0x" || p[:2] == "0X"):
+			base, p = 16, p[2:]
+			if len(p) == 0 {
+				p = "0" // "0x" is 0 for inet_aton
+			}
+		case len(p) > 1 && p[0] == '0':
+			base, p = 8, p[1:]
+		}
+
+		v, err := strconv.ParseUint(p, base, 32)
+		if err != nil || strings.ContainsAny(p, "+-_") {
+			return netip.Addr{}, false
+		}
+
+		bits := 8
+		if n == len(parts)-1 {
+			bits = 8 * (4 - n)
+		}
+		if v >= 1<<bits {
+			return netip.Addr{}, false
+		}
+		ip = ip<<bits | v
+	}
+
+	return netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)}), true
+}
+
+// ranges that are not routed on the internet, besides the ones netip.Addr has methods for (RFC 6890)
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),   // documentation
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("192.88.99.0/24"), // 6to4 relay anycast
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved, and broadcast
+	netip.MustParsePrefix("100::/64"),       // discard
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64, the embedded IPv4 address can be private
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local NAT64
+	netip.MustParsePrefix("2001::/32"),      // Teredo, tunnels to an embedded IPv4 address
+	netip.MustParsePrefix("2001:db8::/32"),  // documentation
+	netip.MustParsePrefix("2002::/16"),      // 6to4, tunnels to an embedded IPv4 address
+	netip.MustParsePrefix("fec0::/10"),      // site-local, deprecated
+}
+
+/*
+	The address is routed on the internet: not private, loopback, link-local, multicast, unspecified, or reserved
+	NAT64, 6to4 and Teredo addresses are not public, they can embed a private IPv4 address
+	IPv4-mapped IPv6 addresses ("::ffff:10.0.0.1") are checked as the IPv4 address
+*/
+func isPublicIP(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return false
+	}
+
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+	Host name (RFC 1123): labels of letters, digits and hyphens, 1-63 characters, not starting or ending with a hyphen, 253 characters at most
+	A trailing dot is allowed, the last label cannot be all digits, so an IPv4 address is not a host name
+*/
+func isHostname(s string) bool {
+	s = strings.TrimSuffix(s, ".")
+	if len(s) == 0 || len(s) > 253 {
+		return false
+	}
+
+	labels := strings.Split(s, ".")
+	for _, l := range labels {
+		if len(l) == 0 || len(l) > 63 || l[0] == '-' || l[len(l)-1] == '-' {
+			return false
+		}
+		for _, c := range l {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+
+	return len(labels) == 1 || !isDigits(labels[len(labels)-1])
+}
+
+// decimal port number 1-65535, no sign or leading zeros
+func parsePort(s string) (int, bool) {
+	if !isDigits(s) || (len(s) > 1 && s[0] == '0') {
+		return 0, false
+	}
+
+	port, err := strconv.Atoi(s)
+	return port, err == nil && port >= 1 && port <= 65535
+}
+
+// -----------------------
+
+type ip struct {
+	family IPFamily
+}
+
+/*
+	IP address, FAMILY is IPAny, IPv4 or IPv6
+	The entry is normalized to the canonical form, "2001:DB8:0:0::1" -> "2001:db8::1", see Normalizer
+*/
+func IP(family IPFamily) Rule {
+	return &ip{family}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (i *ip) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if _, ok := parseIP(field, i.family); ok {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["ip"]), nil
+}
+
+func (i *ip) Normalize(field string) string {
+	if addr, ok := parseIP(field, i.family); ok {
+		return addr.String()
+	}
+	return field
+}
+
+// -----------------------
+
+type publicIP struct {
+	ip
+}
+
+/*
+	IP address that is routed on the internet, not private, loopback, link-local, multicast or reserved
+	FAMILY is IPAny, IPv4 or IPv6, the entry is normalized like IP()
+*/
+func PublicIP(family IPFamily) Rule {
+	return &publicIP{ip{family}}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (p *publicIP) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	addr, ok := parseIP(field, p.family)
+	if !ok {
+		return errors.New(errorMessages["ip"]), nil
+	}
+
+	if isPublicIP(addr) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["ip_public"]), nil
+}
+
+// -----------------------
+
+type cidr struct {
+	family    IPFamily
+	minPrefix int
+	maxPrefix int
+}
+
+/*
+	Network in CIDR notation, "192.168.0.0/16" or "2001:db8::/32"
+	The prefix length must be between MINPREFIX and MAXPREFIX (0, 0 for any)
+	Host bits may be set, the entry is normalized to the network: "192.168.1.1/16" -> "192.168.0.0/16", see Normalizer
+*/
+func CIDR(family IPFamily, minPrefix, maxPrefix int) Rule {
+	return &cidr{family, minPrefix, maxPrefix}
+}
+
+func (c *cidr) parse(s string) (netip.Prefix, bool) {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return prefix, false
+	}
+
+	_, ok := parseIP(prefix.Addr().String(), c.family)
+	return prefix, ok
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (c *cidr) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	prefix, ok := c.parse(field)
+	if !ok {
+		return errors.New(errorMessages["cidr"]), nil
+	}
+
+	if c.minPrefix == 0 && c.maxPrefix == 0 {
+		return nil, nil
+	}
+
+	if prefix.Bits() >= c.minPrefix && prefix.Bits() <= c.maxPrefix {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["cidr_prefix"]), []interface{}{c.minPrefix, c.maxPrefix}
+}
+
+func (c *cidr) Normalize(field string) string {
+	if prefix, ok := c.parse(field); ok {
+		return prefix.Masked().String()
+	}
+	return field
+}
+
+// -----------------------
+
+type mac struct {
+}
+
+/*
+	MAC address, EUI-48 or EUI-64: "00:00:5e:00:53:01", "00-00-5E-00-53-01" or "0000.5e00.5301"
+	The entry is normalized to lower case with colons, see Normalizer
+*/
+func MAC() Rule {
+	return &mac{}
+}
+
+func parseMAC(s string) (net.HardwareAddr, bool) {
+	hw, err := net.ParseMAC(s)
+	return hw, err == nil && (len(hw) == 6 || len(hw) == 8) // not 20 byte InfiniBand addresses
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (m *mac) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if _, ok := parseMAC(field); ok {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["mac"]), nil
+}
+
+func (m *mac) Normalize(field string) string {
+	if hw, ok := parseMAC(field); ok {
+		return hw.String()
+	}
+	return field
+}
+
+// -----------------------
+
+type hostname struct {
+}
+
+/*
+	Host name (RFC 1123), "example.com", "db-1.internal"
+	The entry is normalized to lower case without a trailing dot, see Normalizer
+*/
+func Hostname() Rule {
+	return &hostname{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (h *hostname) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if isHostname(field) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["hostname"]), nil
+}
+
+func (h *hostname) Normalize(field string) string {
+	return strings.ToLower(strings.TrimSuffix(field, "."))
+}
+
+// -----------------------
+
+type port struct {
+	min int
+	max int
+}
+
+/*
+	Port number between MIN and MAX, Port(0, 0) accepts 1-65535
+	Ex: Port(1024, 65535) for ports that do not need root
+*/
+func Port(min, max int) Rule {
+	if min == 0 && max == 0 {
+		min, max = 1, 65535
+	}
+	return &port{min, max}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (p *port) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if n, ok := parsePort(field); ok && n >= p.min && n <= p.max {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["port"]), []interface{}{p.min, p.max}
+}
+
+// -----------------------
+
+type hostPort struct {
+}
+
+/*
+	Host and port, "example.com:443", "192.0.2.1:8080" or "[2001:db8::1]:443"
+	The host is a host name (RFC 1123) or an IP address, IPv6 addresses must be in brackets
+*/
+func HostPort() Rule {
+	return &hostPort{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (h *hostPort) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	host, portStr, err := net.SplitHostPort(field)
+	if err == nil {
+		_, isPort := parsePort(portStr)
+		_, isIP := parseIP(host, IPAny)
+		bracketed := strings.HasPrefix(field, "[")
+
+		// "[example.com]:80" is not valid, "1.2.3.4" in brackets neither
+		if isPort && ((bracketed && isIP && strings.Contains(host, ":")) || (!bracketed && (isHostname(host) || isIP))) {
+			return nil, nil
+		}
+	}
+
+	return errors.New(errorMessages["host_port"]), nil
+}
@@ -0,0 +1,296 @@
+package formvalidator
+
+import (
+	"testing"
+)
+
+func Test_ip(t *testing.T) {
+	var list = []struct {
+		field       string
+		family      IPFamily
+		expectation bool
+		normalized  string
+	}{
+		{"", IPAny, true, ""},
+		{"192.0.2.1", IPAny, true, "192.0.2.1"},
+		{"2001:DB8:0:0::1", IPAny, true, "2001:db8::1"},
+		{"::ffff:192.0.2.1", IPAny, true, "::ffff:192.0.2.1"},
+		{"192.0.2.1", IPv4, true, "192.0.2.1"},
+		{"2001:db8::1", IPv4, false, ""},
+		{"2001:db8::1", IPv6, true, "2001:db8::1"},
+		{"192.0.2.1", IPv6, false, ""},
+		{"fe80::1%eth0", IPAny, false, ""},
+		{"192.0.2.256", IPAny, false, ""},
+		{"192.0.2", IPAny, false, ""},
+		{"192.000.002.001", IPAny, false, ""},
+		{"example.com", IPAny, false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IP(l.family)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("ip(%s, %d): Valid[%t]. Expected: %t", l.field, l.family, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("ip(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_publicIP(t *testing.T) {
+	var list = []struct {
+		field string
+		key   string
+	}{
+		{"", ""},
+		{"8.8.8.8", ""},
+		{"2606:4700:4700::1111", ""},
+		{"10.0.0.1", "ip_public"},
+		{"172.16.5.4", "ip_public"},
+		{"192.168.1.1", "ip_public"},
+		{"127.0.0.1", "ip_public"},
+		{"169.254.169.254", "ip_public"}, // cloud metadata
+		{"100.64.0.1", "ip_public"},
+		{"0.0.0.0", "ip_public"},
+		{"255.255.255.255", "ip_public"},
+		{"224.0.0.1", "ip_public"},
+		{"192.0.2.1", "ip_public"},
+		{"::1", "ip_public"},
+		{"fe80::1", "ip_public"},
+		{"fd00::1", "ip_public"},
+		{"2001:db8::1", "ip_public"},
+		{"::ffff:10.0.0.1", "ip_public"},
+		{"64:ff9b::a00:1", "ip_public"}, // NAT64 of 10.0.0.1
+		{"64:ff9b:1::1", "ip_public"},
+		{"2002:a00:1::1", "ip_public"},       // 6to4 of 10.0.0.1
+		{"2001:0:4136:e378::1", "ip_public"}, // Teredo
+		{"fec0::1", "ip_public"},
+		{"192.88.99.1", "ip_public"},
+		{"localhost", "ip"},
+	}
+
+	for _, l := range list {
+		e, _ := PublicIP(IPAny).Validate([]string{l.field}, testMessages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("publicIP(%s): Error[%s]. Expected: %s", l.field, key, l.key)
+		}
+	}
+}
+
+func Test_cidr(t *testing.T) {
+	var list = []struct {
+		field      string
+		family     IPFamily
+		min, max   int
+		key        string
+		normalized string
+	}{
+		{"", IPAny, 0, 0, "", ""},
+		{"192.168.0.0/16", IPAny, 0, 0, "", "192.168.0.0/16"},
+		{"192.168.1.1/16", IPAny, 0, 0, "", "192.168.0.0/16"},
+		{"2001:db8::/32", IPAny, 0, 0, "", "2001:db8::/32"},
+		{"2001:db8::/32", IPv4, 0, 0, "cidr", ""},
+		{"10.0.0.0/8", IPv4, 16, 32, "cidr_prefix", ""},
+		{"10.0.0.0/24", IPv4, 16, 32, "", "10.0.0.0/24"},
+		{"10.0.0.0/33", IPAny, 0, 0, "cidr", ""},
+		{"10.0.0.0", IPAny, 0, 0, "cidr", ""},
+		{"10.0.0.0/", IPAny, 0, 0, "cidr", ""},
+	}
+
+	for _, l := range list {
+		var rule Rule = CIDR(l.family, l.min, l.max)
+
+		e, _ := rule.Validate([]string{l.field}, testMessages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("cidr(%s): Error[%s]. Expected: %s", l.field, key, l.key)
+		}
+
+		if e == nil && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("cidr(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_mac(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+		normalized  string
+	}{
+		{"", true, ""},
+		{"00:00:5e:00:53:01", true, "00:00:5e:00:53:01"},
+		{"00-00-5E-00-53-01", true, "00:00:5e:00:53:01"},
+		{"0000.5e00.5301", true, "00:00:5e:00:53:01"},
+		{"02:00:5e:10:00:00:00:01", true, "02:00:5e:10:00:00:00:01"}, // EUI-64
+		{"00:00:5e:00:53", false, ""},
+		{"00:00:5e:00:53:0g", false, ""},
+		{"00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01", false, ""}, // InfiniBand
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = MAC()
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("mac(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("mac(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_hostname(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+	}{
+		{"", true},
+		{"example.com", true},
+		{"Example.COM.", true},
+		{"localhost", true},
+		{"db-1.internal", true},
+		{"3com.com", true}, // labels may start with a digit (RFC 1123)
+		{"xn--bcher-kva.example", true},
+		{"-example.com", false},
+		{"example-.com", false},
+		{"exa_mple.com", false},
+		{"example..com", false},
+		{".example.com", false},
+		{"192.0.2.1", false},
+		{"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.com", false}, // 64 characters
+		{"bücher.example", false},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := Hostname().Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("hostname(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+	}
+
+	if n := Hostname().(Normalizer).Normalize("Example.COM."); n != "example.com" {
+		t.Errorf("hostname(Example.COM.): Normalized[%s]. Expected: example.com", n)
+	}
+}
+
+func Test_port(t *testing.T) {
+	var list = []struct {
+		field       string
+		min, max    int
+		expectation bool
+	}{
+		{"", 0, 0, true},
+		{"1", 0, 0, true},
+		{"443", 0, 0, true},
+		{"65535", 0, 0, true},
+		{"0", 0, 0, false},
+		{"65536", 0, 0, false},
+		{"080", 0, 0, false},
+		{"+80", 0, 0, false},
+		{"http", 0, 0, false},
+		{"8080", 1024, 65535, true},
+		{"80", 1024, 65535, false},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := Port(l.min, l.max).Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("port(%s, %d, %d): Valid[%t]. Expected: %t", l.field, l.min, l.max, valid, l.expectation)
+		}
+	}
+}
+
+func Test_hostPort(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+	}{
+		{"", true},
+		{"example.com:443", true},
+		{"localhost:8080", true},
+		{"192.0.2.1:8080", true},
+		{"[2001:db8::1]:443", true},
+		{"example.com", false},
+		{"example.com:", false},
+		{"example.com:0", false},
+		{"example.com:65536", false},
+		{"2001:db8::1:443", false},
+		{"[example.com]:443", false},
+		{"[192.0.2.1]:443", false},
+		{"exa mple.com:443", false},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := HostPort().Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("hostPort(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+	}
+}
+
+func Test_parseLegacyIPv4(t *testing.T) {
+	var list = []struct {
+		host     string
+		expected string
+	}{
+		{"2130706433", "127.0.0.1"},
+		{"0x7f000001", "127.0.0.1"},
+		{"017700000001", "127.0.0.1"},
+		{"0177.0.0.1", "127.0.0.1"},
+		{"127.1", "127.0.0.1"},
+		{"127.0.1", "127.0.0.1"},
+		{"0x7f.0x0.0x0.0x1", "127.0.0.1"},
+		{"10.0x10000", "10.1.0.0"},
+		{"0", "0.0.0.0"},
+		{"0x", "0.0.0.0"},
+		{"4294967296", ""}, // more than 32 bits
+		{"256.1.1.1", ""},
+		{"1.2.3.4.5", ""},
+		{"08", ""}, // not octal
+		{"example", ""},
+		{"0xbeef.example", ""},
+		{"1..2", ""},
+		{"+1", ""},
+	}
+
+	for _, l := range list {
+		addr, ok := parseLegacyIPv4(l.host)
+		if r := addr.String(); ok != (len(l.expected) > 0) || (ok && r != l.expected) {
+			t.Errorf("parseLegacyIPv4(%s): [%s, %t]. Expected: %s", l.host, r, ok, l.expected)
+		}
+	}
+}
@@ -5,9 +5,12 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
+
+	"github.com/dholtzmann/formvalidator/checkdigit"
 )
 
+var numericRegexp = regexp.MustCompile(`^[0-9]+$`)
+
 type numeric struct {
 }
 
@@ -28,9 +31,7 @@ func (n *numeric) Validate(fields []string, errorMessages map[string]string) (er
 		return nil, nil
 	}
 
-	numeric := "^[0-9]+$"
-
-	if match, _ := regexp.MatchString(numeric, field); match == true {
+	if numericRegexp.MatchString(field) {
 		return nil, nil
 	}
 
@@ -133,17 +134,23 @@ func (r *floatRange) Validate(fields []string, errorMessages map[string]string)
 
 // -----------------------
 
-type latitude struct {
+type isFloat64Locale struct {
+	locale *Locale
 }
 
-func Latitude() Rule {
-	return &latitude{}
+func IsFloat64Locale(l *Locale) Rule {
+	if l == nil {
+		l = LocaleEnUS
+	}
+	return &isFloat64Locale{l}
 }
 
 /*
+	tests for float64 written for a locale, with its decimal and group separators ("1.234,56" for de-DE, "1,234.56" for en-US)
+	The entry is normalized to "1234.56" for binding, see Normalizer
 	return error - FormError with message and extra data if revelant
 */
-func (l *latitude) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+func (f *isFloat64Locale) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
 	field := getFirstKey(fields)
 
@@ -152,29 +159,38 @@ func (l *latitude) Validate(fields []string, errorMessages map[string]string) (e
 		return nil, nil
 	}
 
-	// regexp taken from 'govalidator'
-	var latitud = "^[-+]?([1-8]?\\d(\\.\\d+)?|90(\\.0+)?)$"
-
-	if match, _ := regexp.MatchString(latitud, field); match == true {
+	if _, e := f.locale.ParseFloat(field); e == nil {
 		return nil, nil
 	}
 
-	return errors.New(errorMessages["latitude"]), nil
+	return errors.New(errorMessages["float"]), nil
+}
+
+func (f *isFloat64Locale) Normalize(field string) string {
+	return normalizeNumber(f.locale, field)
 }
 
 // -----------------------
 
-type longitude struct {
+type intRangeLocale struct {
+	min    int
+	max    int
+	locale *Locale
 }
 
-func Longitude() Rule {
-	return &longitude{}
+func IntRangeLocale(min, max int, l *Locale) Rule {
+	if l == nil {
+		l = LocaleEnUS
+	}
+	return &intRangeLocale{min, max, l}
 }
 
 /*
+	is some number between MIN and MAX? (integers only, group separators of the locale are allowed: "12.500" for de-DE)
+	The entry is normalized to "12500" for binding, see Normalizer
 	return error - FormError with message and extra data if revelant
 */
-func (l *longitude) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+func (r *intRangeLocale) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
 	field := getFirstKey(fields)
 
@@ -183,30 +199,38 @@ func (l *longitude) Validate(fields []string, errorMessages map[string]string) (
 		return nil, nil
 	}
 
-	// regexp taken from 'govalidator'
-	var longitud = "^[-+]?(180(\\.0+)?|((1[0-7]\\d)|([1-9]?\\d))(\\.\\d+)?)$"
-
-	if match, _ := regexp.MatchString(longitud, field); match == true {
+	i, e := r.locale.ParseInt(field)
+	if e == nil && i >= r.min && i <= r.max {
 		return nil, nil
 	}
 
-	return errors.New(errorMessages["longitude"]), nil
+	return errors.New(errorMessages["int_range"]), []interface{}{r.min, r.max}
+}
+
+func (r *intRangeLocale) Normalize(field string) string {
+	return normalizeNumber(r.locale, field)
 }
 
 // -----------------------
 
-type isDate struct {
+type floatRangeLocale struct {
+	min    float64
+	max    float64
+	locale *Locale
 }
 
-func IsDate() Rule {
-	return &isDate{}
+func FloatRangeLocale(min, max float64, l *Locale) Rule {
+	if l == nil {
+		l = LocaleEnUS
+	}
+	return &floatRangeLocale{min, max, l}
 }
 
 /*
-	Is a string a date? (format: DD-MM-YYYY)
+	is some number between MIN and MAX? (float64 written for a locale, see IsFloat64Locale)
 	return error - FormError with message and extra data if revelant
 */
-func (d *isDate) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+func (r *floatRangeLocale) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
 	field := getFirstKey(fields)
 
@@ -215,58 +239,42 @@ func (d *isDate) Validate(fields []string, errorMessages map[string]string) (err
 		return nil, nil
 	}
 
-	// DD-MM-YYYY
-	if _, err := time.Parse("02-01-2006", field); err == nil {
+	i, e := r.locale.ParseFloat(field)
+	if e == nil && i >= r.min && i <= r.max {
 		return nil, nil
 	}
 
-	return errors.New(errorMessages["date"]), nil
-}
-
-// -----------------------
-
-type isTime struct {
+	return errors.New(errorMessages["float_range"]), []interface{}{r.min, r.max}
 }
 
-func IsTime() Rule {
-	return &isTime{}
+func (r *floatRangeLocale) Normalize(field string) string {
+	return normalizeNumber(r.locale, field)
 }
 
-/*
-	Is a string a time? (format: HH:MM:SS)
-	return error - FormError with message and extra data if revelant
-*/
-func (t *isTime) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
-
-	field := getFirstKey(fields)
-
-	// if blank, it is fine
-	if len(field) == 0 {
-		return nil, nil
+// entries that are already normalized (or invalid) are returned unchanged
+func normalizeNumber(l *Locale, field string) string {
+	if n, err := l.NormalizeNumber(field); err == nil {
+		return n
 	}
-
-	// HH:MM:SS
-	if _, err := time.Parse("15:04:05", field); err == nil {
-		return nil, nil
-	}
-
-	return errors.New(errorMessages["time"]), nil
+	return field
 }
 
 // -----------------------
 
-type isDateTime struct {
+// regexp taken from 'govalidator'
+var latitudeRegexp = regexp.MustCompile(`^[-+]?([1-8]?\d(\.\d+)?|90(\.0+)?)$`)
+
+type latitude struct {
 }
 
-func IsDateTime() Rule {
-	return &isDateTime{}
+func Latitude() Rule {
+	return &latitude{}
 }
 
 /*
-	Is a string a datetime? (format: DD-MM-YYYY HH:MM:SS) These are used for timestamps.
 	return error - FormError with message and extra data if revelant
 */
-func (i *isDateTime) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+func (l *latitude) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
 	field := getFirstKey(fields)
 
@@ -275,42 +283,29 @@ func (i *isDateTime) Validate(fields []string, errorMessages map[string]string)
 		return nil, nil
 	}
 
-	// DD-MM-YYYY HH:MM:SS
-	if _, err := time.Parse("02-01-2006 15:04:05", field); err == nil {
+	if latitudeRegexp.MatchString(field) {
 		return nil, nil
 	}
 
-	return errors.New(errorMessages["date_time"]), nil
+	return errors.New(errorMessages["latitude"]), nil
 }
 
 // -----------------------
 
-type isUUID struct {
-	regex string
-}
-
-func IsUUID(version uint32) Rule {
-	var regex string
-	switch version { // regexp taken from 'govalidator'
-	case 3:
-		regex = "^[0-9a-f]{8}-[0-9a-f]{4}-3[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$"
-	case 4:
-		regex = "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
-	case 5:
-		regex = "^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+// regexp taken from 'govalidator'
+var longitudeRegexp = regexp.MustCompile(`^[-+]?(180(\.0+)?|((1[0-7]\d)|([1-9]?\d))(\.\d+)?)$`)
 
-	default:
-		regex = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
-	}
+type longitude struct {
+}
 
-	return &isUUID{regex}
+func Longitude() Rule {
+	return &longitude{}
 }
 
 /*
-	UUID - universally unique id, used in databases, software, ...
 	return error - FormError with message and extra data if revelant
 */
-func (i *isUUID) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+func (l *longitude) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
 	field := getFirstKey(fields)
 
@@ -319,11 +314,11 @@ func (i *isUUID) Validate(fields []string, errorMessages map[string]string) (err
 		return nil, nil
 	}
 
-	if match, _ := regexp.MatchString(i.regex, field); match == true {
+	if longitudeRegexp.MatchString(field) {
 		return nil, nil
 	}
 
-	return errors.New(errorMessages["uuid"]), nil
+	return errors.New(errorMessages["longitude"]), nil
 }
 
 // -----------------------
@@ -346,8 +341,7 @@ func (i *isbn) Validate(fields []string, errorMessages map[string]string) (error
 		return nil, nil
 	}
 
-	r := strings.NewReplacer(" ", "", "-", "") // remove whitespace and hypthens
-	field = r.Replace(field)
+	field = separatorReplacer.Replace(field) // remove whitespace and hypthens
 
 	if len(field) == 10 {
 		rule := ISBN10()
@@ -362,6 +356,8 @@ func (i *isbn) Validate(fields []string, errorMessages map[string]string) (error
 
 // -----------------------
 
+var isbn10Regexp = regexp.MustCompile(`^(?:[0-9]{9}X|[0-9]{10})$`)
+
 type isbn10 struct {
 }
 
@@ -380,26 +376,13 @@ func (i *isbn10) Validate(fields []string, errorMessages map[string]string) (err
 		return nil, nil
 	}
 
-	r := strings.NewReplacer(" ", "", "-", "") // remove whitespace and hypthens
-	field = r.Replace(field)
-
-	var ISBN10 string = "^(?:[0-9]{9}X|[0-9]{10})$"
+	field = separatorReplacer.Replace(field) // remove whitespace and hypthens
 
-	if match, _ := regexp.MatchString(ISBN10, field); match == false {
+	if !isbn10Regexp.MatchString(field) {
 		return errors.New(errorMessages["isbn"]), nil
 	}
 
-	// run checksum algorithm
-	var checksum, j uint32
-	for j = 0; j < 9; j++ {
-		checksum += (j + 1) * uint32(field[j]-'0')
-	}
-	if field[9] == 'X' {
-		checksum += 10 * 10
-	} else {
-		checksum += 10 * uint32(field[9]-'0')
-	}
-	if checksum%11 == 0 {
+	if checkdigit.Mod11.Verify(field) {
 		return nil, nil
 	}
 
@@ -408,6 +391,8 @@ func (i *isbn10) Validate(fields []string, errorMessages map[string]string) (err
 
 // -----------------------
 
+var isbn13Regexp = regexp.MustCompile(`^(?:[0-9]{13})$`)
+
 type isbn13 struct {
 }
 
@@ -426,24 +411,53 @@ func (i *isbn13) Validate(fields []string, errorMessages map[string]string) (err
 		return nil, nil
 	}
 
-	r := strings.NewReplacer(" ", "", "-", "") // remove whitespace and hypthens
-	field = r.Replace(field)
+	field = separatorReplacer.Replace(field) // remove whitespace and hypthens
 
-	var ISBN13 string = "^(?:[0-9]{13})$"
-
-	if match, _ := regexp.MatchString(ISBN13, field); match == false {
+	if !isbn13Regexp.MatchString(field) {
 		return errors.New(errorMessages["isbn"]), nil
 	}
 
-	// run checksum algorithm
-	var j, checksum uint32
-	factor := []uint32{1, 3}
-	for j = 0; j < 12; j++ {
-		checksum += factor[j%2] * uint32(field[j]-'0')
-	}
-	if (uint32(field[12]-'0'))-((10-(checksum%10))%10) == 0 {
+	if checkdigit.GS1.Verify(field) {
 		return nil, nil
 	}
 
 	return errors.New(errorMessages["isbn"]), nil
 }
+
+// -----------------------
+
+type checkDigit struct {
+	algorithm checkdigit.Algorithm
+}
+
+/*
+	Number with a check digit, for account, invoice and other in-house numbers: CheckDigit(checkdigit.Verhoeff)
+	See the package 'checkdigit' for the algorithms, or implement checkdigit.Algorithm
+	Spaces and hyphens are allowed, the entry is normalized to upper case without them, see Normalizer
+*/
+func CheckDigit(algorithm checkdigit.Algorithm) Rule {
+	return &checkDigit{algorithm}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (c *checkDigit) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if c.algorithm.Verify(c.Normalize(field)) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["check_digit"]), nil
+}
+
+func (c *checkDigit) Normalize(field string) string {
+	return separatorReplacer.Replace(strings.ToUpper(strings.TrimSpace(field)))
+}
@@ -2,6 +2,8 @@ package formvalidator
 
 import (
 	"testing"
+
+	"github.com/dholtzmann/formvalidator/checkdigit"
 )
 
 func Test_numeric(t *testing.T) {
@@ -425,56 +427,6 @@ func Test_isDateTime(t *testing.T) {
 	}
 }
 
-func Test_isUUID(t *testing.T) {
-	var list = []struct {
-		field       string
-		version     uint32
-		expectation bool
-	}{
-		{"", 0, true}, // unknown version
-		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 0, false},
-		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3xxx", 0, false},
-		{"a987fbc94bed3078cf079141ba07c9f3", 0, false},
-		{"934859", 0, false},
-		{"987fbc9-4bed-3078-cf07a-9141ba07c9f3", 0, false},
-		{"aaaaaaaa-1111-1111-aaag-111111111111", 0, false},
-		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", 0, true},
-
-		{"", 3, true}, // v3
-		{"412452646", 3, false},
-		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 3, false},
-		{"a987fbc9-4bed-4078-8f07-9141ba07c9f3", 3, false},
-		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", 3, true},
-
-		{"", 4, true}, // v4
-		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 4, false},
-		{"a987fbc9-4bed-5078-af07-9141ba07c9f3", 4, false},
-		{"934859", 4, false},
-		{"57b73598-8764-4ad0-a76a-679bb6640eb1", 4, true},
-		{"625e63f3-58f5-40b7-83a1-a72ad31acffb", 4, true},
-
-		{"", 5, true}, // v5
-		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 5, false},
-		{"9c858901-8a57-4791-81fe-4c455b099bc9", 5, false},
-		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", 5, false},
-		{"987fbc97-4bed-5078-af07-9141ba07c9f3", 5, true},
-		{"987fbc97-4bed-5078-9f07-9141ba07c9f3", 5, true},
-	}
-
-	for _, l := range list {
-		valid := false
-		var rule Rule = IsUUID(l.version)
-
-		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
-			valid = true
-		}
-
-		if l.expectation != valid {
-			t.Errorf("isUUID(%d, '%s'): Valid[%t]. Expected: %t", l.version, l.field, valid, l.expectation)
-		}
-	}
-}
-
 func Test_isbn(t *testing.T) { // no version
 	var list = []struct {
 		field       string
@@ -589,3 +541,150 @@ func Test_isbn13(t *testing.T) {
 		}
 	}
 }
+
+func Test_isFloat64Locale(t *testing.T) {
+	var list = []struct {
+		field       string
+		locale      *Locale
+		expectation bool
+		normalized  string
+	}{
+		{"", LocaleDeDE, true, ""},
+		{"1.234,56", LocaleDeDE, true, "1234.56"},
+		{"-10,50", LocaleDeDE, true, "-10.50"},
+		{"1234,5", LocaleDeDE, true, "1234.5"},
+		{",5", LocaleDeDE, true, ".5"},
+		{"1.234.567", LocaleDeDE, true, "1234567"},
+		{"1.234,56", LocalePtBR, true, "1234.56"},
+		{"1,234.56", LocaleEnUS, true, "1234.56"},
+		{"1234.56", LocaleEnUS, true, "1234.56"},
+		{"1 234,56", LocaleFrFR, true, "1234.56"},
+		{"1 234,56", LocaleFrFR, true, "1234.56"},
+		{"1,234.56", LocaleDeDE, false, ""},
+		{"12.34", LocaleDeDE, false, ""},
+		{"1.2345,6", LocaleDeDE, false, ""},
+		{"1234,", LocaleDeDE, false, ""},
+		{"1,2,3", LocaleDeDE, false, ""},
+		{"1.234,56", LocaleEnUS, false, ""},
+		{"1  234", LocaleFrFR, false, ""},
+		{"abc", LocaleDeDE, false, ""},
+		{"--5", LocaleDeDE, false, ""},
+		{"-", LocaleDeDE, false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IsFloat64Locale(l.locale)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("isFloat64Locale(%s, %s): Valid[%t]. Expected: %t", l.locale.Name, l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("isFloat64Locale(%s, %s): Normalized[%s]. Expected: %s", l.locale.Name, l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_intRangeLocale(t *testing.T) {
+	var list = []struct {
+		field       string
+		min         int
+		max         int
+		expectation bool
+	}{
+		{"", 1, 2, true},
+		{"12.500", 10000, 20000, true},
+		{"12500", 10000, 20000, true},
+		{"-1.000", -1000, 0, true},
+		{"25.000", 10000, 20000, false},
+		{"12,5", 1, 20, false},
+		{"12.50", 1, 2000, false},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IntRangeLocale(l.min, l.max, LocaleDeDE)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("intRangeLocale(%s, min:%d, max:%d): Valid[%t]. Expected: %t", l.field, l.min, l.max, valid, l.expectation)
+		}
+	}
+}
+
+func Test_floatRangeLocale(t *testing.T) {
+	var list = []struct {
+		field       string
+		min         float64
+		max         float64
+		expectation bool
+	}{
+		{"", 1, 2, true},
+		{"1,5", 1, 2, true},
+		{"1.999,99", 1000, 2000, true},
+		{"-0,25", -1, 0, true},
+		{"2,5", 1, 2, false},
+		{"1.5", 1, 2, false},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = FloatRangeLocale(l.min, l.max, LocaleDeDE)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("floatRangeLocale(%s, min:%f, max:%f): Valid[%t]. Expected: %t", l.field, l.min, l.max, valid, l.expectation)
+		}
+	}
+}
+
+func Test_checkDigit(t *testing.T) {
+	var list = []struct {
+		field       string
+		algorithm   checkdigit.Algorithm
+		expectation bool
+		normalized  string
+	}{
+		{"", checkdigit.Luhn, true, ""},
+		{"7992 7398 713", checkdigit.Luhn, true, "79927398713"},
+		{"7992-7398-710", checkdigit.Luhn, false, ""},
+		{"2363", checkdigit.Verhoeff, true, "2363"},
+		{"2364", checkdigit.Verhoeff, false, ""},
+		{"5724", checkdigit.Damm, true, "5724"},
+		{"0000-0002-1694-233x", checkdigit.Mod11_2, true, "000000021694233X"},
+		{"0000-0002-1694-2338", checkdigit.Mod11_2, false, ""},
+		{"abc", checkdigit.GS1, false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = CheckDigit(l.algorithm)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("checkDigit(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("checkDigit(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package formvalidator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// type of a phone number, see Phone()
+type PhoneType string
+
+const (
+	PhoneMobile    PhoneType = "mobile"
+	PhoneFixedLine PhoneType = "fixed_line"
+)
+
+/*
+	Numbering plan of a region (keyed by lowercase ISO 3166 codes)
+
+	callingCode - country calling code, "+49"
+	trunkPrefix - dialed before national numbers and dropped in E.164 ("0" in Germany, "1" in the US), "" if there is none
+	mobile, fixed - patterns of the national significant number (without calling code or trunk prefix)
+
+	The patterns follow the published numbering plans in broad strokes, they catch typos and wrong lengths, not unassigned ranges.
+	The NANP (+1) and Mexico do not separate mobile and fixed-line numbers, both patterns are the same.
+*/
+type phoneRegion struct {
+	callingCode string
+	trunkPrefix string
+	mobile      *regexp.Regexp
+	fixed       *regexp.Regexp
+}
+
+func newPhoneRegion(callingCode, trunkPrefix, mobile, fixed string) *phoneRegion {
+	return &phoneRegion{callingCode, trunkPrefix, regexp.MustCompile("^(?:" + mobile + ")$"), regexp.MustCompile("^(?:" + fixed + ")$")}
+}
+
+var phoneRegions = map[string]*phoneRegion{
+	"ae": newPhoneRegion("971", "0", `5[024-68]\d{7}`, `[2-4679]\d{7}`),
+	"at": newPhoneRegion("43", "0", `6[5-9]\d{5,11}`, `[1-57]\d{3,12}`),
+	"au": newPhoneRegion("61", "0", `4\d{8}`, `[2378]\d{8}`),
+	"be": newPhoneRegion("32", "0", `4[5-9]\d{7}`, `[1-35-9]\d{7}`),
+	"br": newPhoneRegion("55", "0", `[1-9][1-9]9\d{8}`, `[1-9][1-9][2-5]\d{7}`),
+	"ca": newPhoneRegion("1", "1", `[2-9]\d{2}[2-9]\d{6}`, `[2-9]\d{2}[2-9]\d{6}`),
+	"ch": newPhoneRegion("41", "0", `7[5-9]\d{7}`, `(?:[2-6]\d|[89]1)\d{7}`),
+	"cn": newPhoneRegion("86", "0", `1[3-9]\d{9}`, `[2-9]\d{8,10}`),
+	"de": newPhoneRegion("49", "0", `1[5-7]\d{8,9}`, `[2-9]\d{5,10}`),
+	"dk": newPhoneRegion("45", "", `[2-9]\d{7}`, `[2-9]\d{7}`),
+	"es": newPhoneRegion("34", "", `(?:6\d|7[1-4])\d{7}`, `[89]\d{8}`),
+	"fr": newPhoneRegion("33", "0", `[67]\d{8}`, `[1-59]\d{8}`),
+	"gb": newPhoneRegion("44", "0", `7[1-57-9]\d{8}`, `[12]\d{8,9}`),
+	"hk": newPhoneRegion("852", "", `[5-79]\d{7}`, `[23]\d{7}`),
+	"ie": newPhoneRegion("353", "0", `8[35-9]\d{7}`, `[124-79]\d{6,8}`),
+	"il": newPhoneRegion("972", "0", `5\d{8}`, `[2-489]\d{7}`),
+	"in": newPhoneRegion("91", "0", `[6-9]\d{9}`, `[1-5]\d{9}`),
+	"it": newPhoneRegion("39", "", `3\d{8,9}`, `0\d{5,10}`),
+	"jp": newPhoneRegion("81", "0", `[789]0\d{8}`, `[1-9]\d{8}`),
+	"kr": newPhoneRegion("82", "0", `10\d{8}|1[16-9]\d{7,8}`, `[2-6]\d{7,9}`),
+	"mx": newPhoneRegion("52", "", `[2-9]\d{9}`, `[2-9]\d{9}`),
+	"nl": newPhoneRegion("31", "0", `6[1-58]\d{7}`, `[1-57]\d{8}`),
+	"no": newPhoneRegion("47", "", `[49]\d{7}`, `[235-7]\d{7}`),
+	"nz": newPhoneRegion("64", "0", `2\d{7,9}`, `[3-9]\d{7}`),
+	"pl": newPhoneRegion("48", "", `(?:45|5[0137]|6[069]|7[2389]|88)\d{7}`, `(?:1[2-8]|2[2-69]|3[2-4]|4[1-468]|5[24-689]|6[1-3578]|7[14-7]|8[1-79]|9[145])\d{7}`),
+	"pt": newPhoneRegion("351", "", `9[1236]\d{7}`, `2\d{8}`),
+	"ru": newPhoneRegion("7", "8", `9\d{9}`, `[348]\d{9}`),
+	"se": newPhoneRegion("46", "0", `7[02369]\d{7}`, `[1-689]\d{6,8}`),
+	"sg": newPhoneRegion("65", "", `[89]\d{7}`, `6\d{7}`),
+	"tr": newPhoneRegion("90", "0", `5\d{9}`, `[2-4]\d{9}`),
+	"us": newPhoneRegion("1", "1", `[2-9]\d{2}[2-9]\d{6}`, `[2-9]\d{2}[2-9]\d{6}`),
+	"za": newPhoneRegion("27", "0", `[6-8]\d{8}`, `[1-5]\d{8}`),
+}
+
+var ErrInvalidPhone = errors.New("String is not a valid phone number!")
+
+// formatting that people type in phone numbers: "+44 (0)20 7946-0958", "(212) 555.0123"
+var phoneReplacer = strings.NewReplacer("(0)", "", " ", "", "-", "", ".", "", "(", "", ")", "", "/", "")
+
+/*
+	Parse a phone number in international ("+49 30 123456", "0049 30 123456") or national format ("030 123456" with region "de")
+	Returns the E.164 form ("+4930123456") and the types the number matches, nil types if the calling code has no metadata.
+*/
+func parsePhone(number, defaultRegion string) (string, []PhoneType, error) {
+	number = phoneReplacer.Replace(strings.TrimSpace(number))
+
+	if strings.HasPrefix(number, "00") {
+		number = "+" + number[2:]
+	}
+
+	if strings.HasPrefix(number, "+") {
+		digits := number[1:]
+		if !isDigits(digits) || digits[0] == '0' {
+			return "", nil, ErrInvalidPhone
+		}
+
+		// calling codes are a prefix code, at most one of 1-3 digits matches
+		var regions []*phoneRegion
+		for _, r := range phoneRegions {
+			if strings.HasPrefix(digits, r.callingCode) {
+				regions = append(regions, r)
+			}
+		}
+
+		if len(regions) == 0 { // no metadata, only the length limits of E.164
+			if len(digits) < 8 || len(digits) > 15 {
+				return "", nil, ErrInvalidPhone
+			}
+			return number, nil, nil
+		}
+
+		return matchPhone(regions, digits[len(regions[0].callingCode):])
+	}
+
+	r, ok := phoneRegions[strings.ToLower(defaultRegion)]
+	if !ok || !isDigits(number) {
+		return "", nil, ErrInvalidPhone
+	}
+
+	if len(r.trunkPrefix) > 0 && strings.HasPrefix(number, r.trunkPrefix) {
+		if e164, types, err := matchPhone([]*phoneRegion{r}, number[len(r.trunkPrefix):]); err == nil {
+			return e164, types, nil
+		}
+	}
+
+	return matchPhone([]*phoneRegion{r}, number)
+}
+
+// national significant number against the patterns of the regions that share a calling code
+func matchPhone(regions []*phoneRegion, nsn string) (string, []PhoneType, error) {
+	var types []PhoneType
+	for _, r := range regions {
+		if r.mobile.MatchString(nsn) && !phoneTypeIn(types, PhoneMobile) {
+			types = append(types, PhoneMobile)
+		}
+		if r.fixed.MatchString(nsn) && !phoneTypeIn(types, PhoneFixedLine) {
+			types = append(types, PhoneFixedLine)
+		}
+	}
+
+	if len(types) == 0 {
+		return "", nil, ErrInvalidPhone
+	}
+
+	return "+" + regions[0].callingCode + nsn, types, nil
+}
+
+func phoneTypeIn(types []PhoneType, t PhoneType) bool {
+	for _, j := range types {
+		if j == t {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+	Format a phone number as E.164 ("+4930123456"), national numbers use the default region (lowercase ISO 3166: "de")
+*/
+func FormatE164(number, defaultRegion string) (string, error) {
+	e164, _, err := parsePhone(number, defaultRegion)
+	return e164, err
+}
+
+// -----------------------
+
+type phone struct {
+	defaultRegion string
+	types         []PhoneType
+}
+
+/*
+	Phone number in international format, or national format for the default region (lowercase ISO 3166, same as CountryCode())
+	The region can come from another field: Phone(form.Get("Country"))
+	If TYPES are given, the number must be one of them: Phone("de", PhoneMobile)
+	The entry is normalized to E.164 "+4915123456789", see Normalizer
+
+	Metadata covers the major regions, numbers with other calling codes are only checked for the length limits of E.164.
+*/
+func Phone(defaultRegion string, types ...PhoneType) Rule {
+	return &phone{defaultRegion, types}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (p *phone) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	_, types, err := parsePhone(field, p.defaultRegion)
+	if err != nil {
+		return errors.New(errorMessages["phone"]), nil
+	}
+
+	if len(p.types) == 0 {
+		return nil, nil
+	}
+
+	for _, t := range p.types {
+		if phoneTypeIn(types, t) {
+			return nil, nil
+		}
+	}
+
+	return errors.New(errorMessages["phone_type"]), nil
+}
+
+func (p *phone) Normalize(field string) string {
+	if e164, _, err := parsePhone(field, p.defaultRegion); err == nil {
+		return e164
+	}
+	return field
+}
@@ -0,0 +1,73 @@
+package formvalidator
+
+import (
+	"testing"
+)
+
+func Test_phone(t *testing.T) {
+	var list = []struct {
+		field       string
+		region      string
+		types       []PhoneType
+		expectation bool
+		normalized  string
+	}{
+		{"", "us", nil, true, ""},
+		{"(212) 555-0123", "us", nil, true, "+12125550123"},
+		{"1 212 555 0123", "us", nil, true, "+12125550123"},
+		{"+1 212.555.0123", "", nil, true, "+12125550123"},
+		{"+44 (0)20 7946 0958", "", nil, true, "+442079460958"},
+		{"020 7946 0958", "gb", nil, true, "+442079460958"},
+		{"07700 900123", "GB", []PhoneType{PhoneMobile}, true, "+447700900123"},
+		{"030 123456", "de", nil, true, "+4930123456"},
+		{"0049 151 23456789", "fr", nil, true, "+4915123456789"},
+		{"0151 23456789", "de", []PhoneType{PhoneMobile}, true, "+4915123456789"},
+		{"06 12 34 56 78", "fr", nil, true, "+33612345678"},
+		{"06 1234 5678", "it", nil, true, "+390612345678"}, // Italian numbers keep the leading 0
+		{"(11) 91234-5678", "br", []PhoneType{PhoneMobile}, true, "+5511912345678"},
+		{"8 912 345-67-89", "ru", nil, true, "+79123456789"},
+		{"+234 803 123 4567", "", nil, true, "+2348031234567"}, // no metadata, E.164 length only
+		{"030 123456", "de", []PhoneType{PhoneMobile}, false, ""},
+		{"020 7946 0958", "gb", []PhoneType{PhoneMobile}, false, ""},
+		{"(112) 555-0123", "us", nil, false, ""}, // area codes do not start with 1
+		{"212 555 012", "us", nil, false, ""},
+		{"+1 212 555 01234", "", nil, false, ""},
+		{"020 7946 0958", "", nil, false, ""}, // national format needs a region
+		{"020 7946 0958", "xx", nil, false, ""},
+		{"+0 212 555 0123", "", nil, false, ""},
+		{"+234 803", "", nil, false, ""},
+		{"212-555-CALL", "us", nil, false, ""},
+		{"++1 212 555 0123", "", nil, false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = Phone(l.region, l.types...)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("phone(%s, %s): Valid[%t]. Expected: %t", l.field, l.region, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("phone(%s, %s): Normalized[%s]. Expected: %s", l.field, l.region, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_phoneType(t *testing.T) {
+	var rule Rule = Phone("de", PhoneMobile)
+
+	if e, _ := rule.Validate([]string{"030 123456"}, testMessages); messageKey(e) != "phone_type" {
+		t.Errorf("phone(030 123456): Error[%v]. Expected: phone_type", e)
+	}
+
+	if e, _ := rule.Validate([]string{"030"}, testMessages); messageKey(e) != "phone" {
+		t.Errorf("phone(030): Error[%v]. Expected: phone", e)
+	}
+}
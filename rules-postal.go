@@ -0,0 +1,179 @@
+package formvalidator
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+/*
+	Postal code format of a country (keyed by lowercase ISO 3166 codes, same as CountryCode())
+
+	pattern - the code in upper case without spaces or hyphens, "SW1A1AA"
+	split, separator - canonical form, the separator is written after 'split' characters, counted from the end if negative ("SW1A 1AA": -3, " ")
+*/
+type postalFormat struct {
+	pattern   *regexp.Regexp
+	split     int
+	separator string
+}
+
+func newPostalFormat(pattern string, split int, separator string) *postalFormat {
+	return &postalFormat{regexp.MustCompile("^(?:" + pattern + ")$"), split, separator}
+}
+
+var postalFormats = map[string]*postalFormat{
+	"ar": newPostalFormat(`\d{4}|[A-HJ-NP-Z]\d{4}[A-Z]{3}`, 0, ""),
+	"at": newPostalFormat(`\d{4}`, 0, ""),
+	"au": newPostalFormat(`\d{4}`, 0, ""),
+	"bd": newPostalFormat(`\d{4}`, 0, ""),
+	"be": newPostalFormat(`\d{4}`, 0, ""),
+	"bg": newPostalFormat(`\d{4}`, 0, ""),
+	"br": newPostalFormat(`\d{8}`, 5, "-"),
+	"ca": newPostalFormat(`[ABCEGHJ-NPRSTVXY]\d[ABCEGHJ-NPRSTV-Z]\d[ABCEGHJ-NPRSTV-Z]\d`, 3, " "),
+	"ch": newPostalFormat(`\d{4}`, 0, ""),
+	"cl": newPostalFormat(`\d{7}`, 0, ""),
+	"cn": newPostalFormat(`\d{6}`, 0, ""),
+	"co": newPostalFormat(`\d{6}`, 0, ""),
+	"cz": newPostalFormat(`\d{5}`, 3, " "),
+	"de": newPostalFormat(`\d{5}`, 0, ""),
+	"dk": newPostalFormat(`\d{4}`, 0, ""),
+	"ee": newPostalFormat(`\d{5}`, 0, ""),
+	"eg": newPostalFormat(`\d{5}`, 0, ""),
+	"es": newPostalFormat(`(?:0[1-9]|[1-4]\d|5[0-2])\d{3}`, 0, ""),
+	"fi": newPostalFormat(`\d{5}`, 0, ""),
+	"fr": newPostalFormat(`\d{5}`, 0, ""),
+	"gb": newPostalFormat(`GIR0AA|[A-PR-UWYZ](?:\d{1,2}|[A-HK-Y]\d{1,2}|\d[A-HJKPSTUW]|[A-HK-Y]\d[ABEHMNPRV-Y])\d[ABD-HJLNP-UW-Z]{2}`, -3, " "),
+	"gr": newPostalFormat(`\d{5}`, 3, " "),
+	"hr": newPostalFormat(`\d{5}`, 0, ""),
+	"hu": newPostalFormat(`\d{4}`, 0, ""),
+	"id": newPostalFormat(`\d{5}`, 0, ""),
+	"ie": newPostalFormat(`(?:[AC-FHKNPRTV-Y]\d{2}|D6W)[0-9AC-FHKNPRTV-Y]{4}`, 3, " "), // Eircode
+	"il": newPostalFormat(`\d{7}`, 0, ""),
+	"in": newPostalFormat(`[1-9]\d{5}`, 0, ""),
+	"is": newPostalFormat(`\d{3}`, 0, ""),
+	"it": newPostalFormat(`\d{5}`, 0, ""),
+	"jp": newPostalFormat(`\d{7}`, 3, "-"),
+	"ke": newPostalFormat(`\d{5}`, 0, ""),
+	"kr": newPostalFormat(`\d{5}`, 0, ""),
+	"lk": newPostalFormat(`\d{5}`, 0, ""),
+	"lt": newPostalFormat(`\d{5}`, 0, ""),
+	"lu": newPostalFormat(`\d{4}`, 0, ""),
+	"ma": newPostalFormat(`\d{5}`, 0, ""),
+	"mx": newPostalFormat(`\d{5}`, 0, ""),
+	"my": newPostalFormat(`\d{5}`, 0, ""),
+	"ng": newPostalFormat(`\d{6}`, 0, ""),
+	"nl": newPostalFormat(`[1-9]\d{3}[A-Z]{2}`, 4, " "),
+	"no": newPostalFormat(`\d{4}`, 0, ""),
+	"nz": newPostalFormat(`\d{4}`, 0, ""),
+	"pe": newPostalFormat(`\d{5}`, 0, ""),
+	"ph": newPostalFormat(`\d{4}`, 0, ""),
+	"pk": newPostalFormat(`\d{5}`, 0, ""),
+	"pl": newPostalFormat(`\d{5}`, 2, "-"),
+	"pt": newPostalFormat(`\d{7}`, 4, "-"),
+	"ro": newPostalFormat(`\d{6}`, 0, ""),
+	"ru": newPostalFormat(`\d{6}`, 0, ""),
+	"sa": newPostalFormat(`\d{5}(?:\d{4})?`, 5, "-"),
+	"se": newPostalFormat(`\d{5}`, 3, " "),
+	"sg": newPostalFormat(`\d{6}`, 0, ""),
+	"si": newPostalFormat(`\d{4}`, 0, ""),
+	"sk": newPostalFormat(`\d{5}`, 3, " "),
+	"th": newPostalFormat(`\d{5}`, 0, ""),
+	"tr": newPostalFormat(`\d{5}`, 0, ""),
+	"tw": newPostalFormat(`\d{3}(?:\d{2,3})?`, 0, ""),
+	"ua": newPostalFormat(`\d{5}`, 0, ""),
+	"us": newPostalFormat(`\d{5}(?:\d{4})?`, 5, "-"), // ZIP or ZIP+4
+	"vn": newPostalFormat(`\d{6}`, 0, ""),
+	"za": newPostalFormat(`\d{4}`, 0, ""),
+}
+
+// countries that do not use postal codes
+var noPostalCodes = []string{"ae", "ag", "ao", "aw", "bf", "bi", "bj", "bo", "bs", "bw", "bz", "cd", "cf", "cg", "ci", "ck", "cm", "dj", "dm", "er", "fj", "gd", "gh", "gm", "gq", "gy", "hk", "hm", "ki", "km", "kn", "kp", "lc", "ml", "mo", "mr", "ms", "mw", "nr", "nu", "qa", "rw", "sb", "sc", "sl", "so", "sr", "ss", "st", "sy", "tf", "tg", "tk", "tl", "to", "tt", "tv", "ug", "vu", "ye", "zw"}
+
+// countries without a format in the table, after removing spaces and hyphens
+var genericPostalCode = regexp.MustCompile(`^[0-9A-Z]{2,10}$`)
+
+/*
+	Does the country use postal codes? (lowercase ISO 3166: "us")
+	For building the rule chain, a postal code is only Required() where there is one.
+*/
+func HasPostalCode(country string) bool {
+	return !inSlice(noPostalCodes, strings.ToLower(country))
+}
+
+// -----------------------
+
+type postalCode struct {
+	country string
+}
+
+// a postalCode that is a Normalizer, PostalCode(country, false) is not one, so a later normalizer in the chain is used
+type normalizedPostalCode struct {
+	postalCode
+}
+
+/*
+	Postal code for the selected country (lowercase ISO 3166, same as CountryCode())
+	The country comes from another field: PostalCode(form.Get("Country"), true)
+	Spaces, hyphens and letter case are not checked, with NORMALIZE the entry is rewritten to the canonical form: "sw1a1aa" -> "SW1A 1AA", see Normalizer
+
+	Countries without postal codes accept any entry, see HasPostalCode()
+	Countries missing from the format table only need 2-10 letters or digits.
+*/
+func PostalCode(country string, normalize bool) Rule {
+	p := postalCode{strings.ToLower(country)}
+	if normalize {
+		return &normalizedPostalCode{p}
+	}
+	return &p
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (p *postalCode) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if !HasPostalCode(p.country) {
+		return nil, nil
+	}
+
+	code := separatorReplacer.Replace(strings.ToUpper(strings.TrimSpace(field)))
+
+	if f, ok := postalFormats[p.country]; ok {
+		if f.pattern.MatchString(code) {
+			return nil, nil
+		}
+	} else if genericPostalCode.MatchString(code) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["postal_code"]), nil
+}
+
+func (p *normalizedPostalCode) Normalize(field string) string {
+	f, ok := postalFormats[p.country]
+	if !ok {
+		return field
+	}
+
+	code := separatorReplacer.Replace(strings.ToUpper(strings.TrimSpace(field)))
+
+	split := f.split
+	if split < 0 {
+		split += len(code)
+	}
+
+	// "12345" stays a plain ZIP code, the separator is only written when there is something after it
+	if split <= 0 || split >= len(code) {
+		return code
+	}
+
+	return code[:split] + f.separator + code[split:]
+}
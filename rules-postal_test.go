@@ -0,0 +1,89 @@
+package formvalidator
+
+import (
+	"testing"
+)
+
+func Test_postalCode(t *testing.T) {
+	var list = []struct {
+		field       string
+		country     string
+		expectation bool
+		normalized  string
+	}{
+		{"", "us", true, ""},
+		{"90210", "us", true, "90210"},
+		{"90210-1234", "us", true, "90210-1234"},
+		{"902101234", "us", true, "90210-1234"},
+		{"sw1a 1aa", "gb", true, "SW1A 1AA"},
+		{"SW1A1AA", "gb", true, "SW1A 1AA"},
+		{"M1 1AE", "gb", true, "M1 1AE"},
+		{"EC1A 1BB", "GB", true, "EC1A 1BB"},
+		{"k1a0b1", "ca", true, "K1A 0B1"},
+		{"10115", "de", true, "10115"},
+		{"1234ab", "nl", true, "1234 AB"},
+		{"1234 AB", "nl", true, "1234 AB"},
+		{"11455", "se", true, "114 55"},
+		{"00-950", "pl", true, "00-950"},
+		{"1000-001", "pt", true, "1000-001"},
+		{"01310-100", "br", true, "01310-100"},
+		{"D02 X285", "ie", true, "D02 X285"},
+		{"1000", "ch", true, "1000"},
+		{"anything", "hk", true, "anything"}, // no postal codes
+		{"AB-1234", "mt", true, "AB-1234"},   // no format in the table
+		{"9021", "us", false, ""},
+		{"90210-123", "us", false, ""},
+		{"QQ1 1AA", "gb", false, ""},
+		{"D1A 0B1", "ca", false, ""},
+		{"1011", "de", false, ""},
+		{"0123 AB", "nl", false, ""},
+		{"53000", "es", false, ""},
+		{"12#45", "mt", false, ""},
+		{"1", "mt", false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = PostalCode(l.country, true)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("postalCode(%s, %s): Valid[%t]. Expected: %t", l.field, l.country, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("postalCode(%s, %s): Normalized[%s]. Expected: %s", l.field, l.country, n, l.normalized)
+			}
+		}
+	}
+
+	// without normalizing it is not a Normalizer, a later rule in the chain can normalize the entry
+	if _, ok := PostalCode("gb", false).(Normalizer); ok {
+		t.Errorf("PostalCode(gb, false): implements Normalizer")
+	}
+	if n := PostalCode("xx", true).(Normalizer).Normalize("ab-12"); n != "ab-12" {
+		t.Errorf("postalCode(ab-12, xx): Normalized[%s]. Expected: ab-12, no format for the country", n)
+	}
+}
+
+func Test_hasPostalCode(t *testing.T) {
+	var list = []struct {
+		country     string
+		expectation bool
+	}{
+		{"us", true},
+		{"DE", true},
+		{"hk", false},
+		{"ae", false},
+	}
+
+	for _, l := range list {
+		if r := HasPostalCode(l.country); r != l.expectation {
+			t.Errorf("HasPostalCode(%s): [%t]. Expected: %t", l.country, r, l.expectation)
+		}
+	}
+}
@@ -3,11 +3,14 @@ package formvalidator
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
+
+	"github.com/dholtzmann/formvalidator/checkdigit"
 )
 
 type requiredSingle struct{}
@@ -170,6 +173,8 @@ func (m *strMatch) Validate(fields []string, errorMessages map[string]string) (e
 
 // -----------------------
 
+var alphaNumRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
 type alphaNumeric struct {
 }
 
@@ -188,9 +193,7 @@ func (a *alphaNumeric) Validate(fields []string, errorMessages map[string]string
 		return nil, nil
 	}
 
-	var alphanum = "^[a-zA-Z0-9]+$"
-
-	if match, _ := regexp.MatchString(alphanum, field); match == true {
+	if alphaNumRegexp.MatchString(field) {
 		return nil, nil
 	}
 
@@ -276,6 +279,7 @@ func CreditCard(allowTestingNumbers bool) Rule {
 /*
 	Luhn algorithm
 	Note: Does not allow testing card numbers! card numbers can have all digits, or be separated by spaces or hypthens
+	The entry is normalized to digits only, see Normalizer
 */
 func (c *creditCard) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
@@ -286,10 +290,11 @@ func (c *creditCard) Validate(fields []string, errorMessages map[string]string)
 		return nil, nil
 	}
 
-	// change this to remove after 4 digits?
-	// remove spaces and hypthens
-	//	field = strings.Replace(field, " ", "", -1)
-	//	field = strings.Replace(field, "-", "", -1)
+	// remove spaces and hypthens, they may only separate groups of digits
+	if !hasDigitGroups(strings.TrimSpace(field)) {
+		return errors.New(errorMessages["credit_card"]), nil
+	}
+	field = c.Normalize(field)
 
 	// check for testing card numbers
 	// from https://stripe.com/docs/testing
@@ -322,40 +327,23 @@ func (c *creditCard) Validate(fields []string, errorMessages map[string]string)
 		}
 	}
 
-	// taken from 'go-credit-card', Luhn algorithm implementation
-	var sum int
-	var alternate bool
-
 	numberLen := len(field)
 
 	if numberLen < 13 || numberLen > 19 {
 		return errors.New(errorMessages["credit_card"]), nil
 	}
 
-	for i := numberLen - 1; i > -1; i-- {
-		mod, err := strconv.Atoi(string(field[i]))
-		if err != nil {
-			return errors.New(errorMessages["credit_card"]), nil
-		}
-		if alternate {
-			mod *= 2
-			if mod > 9 {
-				mod = (mod % 10) + 1
-			}
-		}
-
-		alternate = !alternate
-
-		sum += mod
-	}
-
-	if sum%10 == 0 {
+	if checkdigit.Luhn.Verify(field) {
 		return nil, nil
 	}
 
 	return errors.New(errorMessages["credit_card"]), nil
 }
 
+func (c *creditCard) Normalize(field string) string {
+	return separatorReplacer.Replace(strings.TrimSpace(field))
+}
+
 // -----------------------
 
 type webRequestURI struct {
@@ -424,6 +412,18 @@ func (i *isJSON) Validate(fields []string, errorMessages map[string]string) (err
 
 // -----------------------
 
+// "bob@example.com" -> "bob", "example.com", at the last '@', false if there is none or a part is empty
+func splitEmail(address string) (string, string, bool) {
+	at := strings.LastIndex(address, "@")
+	if at < 1 || at == len(address)-1 {
+		return "", "", false
+	}
+	return address[:at], address[at+1:], true
+}
+
+// regexp taken from 'govalidator'
+var emailRegexp = regexp.MustCompile("^(((([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|((\\x22)((((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(([\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(\\([\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(\\x22)))@((([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|\\.|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$")
+
 type email struct {
 	allowDisposableDomains bool
 }
@@ -433,7 +433,8 @@ func Email(allowDisposableDomains bool) Rule {
 }
 
 /*
-	Tests e-mail format and optionally if the e-mail address is a "throw-away" account
+	Tests e-mail format and optionally if the e-mail address is a "throw-away" account (see EmailBlocklist and EmailAllowlist)
+	Disposable addresses get the "email" message, EmailAddress() with BlockDisposable reports "email_disposable" and the ListMatch.
 */
 func (e *email) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
 
@@ -444,26 +445,13 @@ func (e *email) Validate(fields []string, errorMessages map[string]string) (erro
 		return nil, nil
 	}
 
-	// regexp taken from 'govalidator'
-	var email = "^(((([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|((\\x22)((((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(([\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(\\([\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(((\\x20|\\x09)*(\\x0d\\x0a))?(\\x20|\\x09)+)?(\\x22)))@((([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|\\.|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])([a-zA-Z]|\\d|-|_|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*([a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$"
-
-	if match, _ := regexp.MatchString(email, field); match == true {
+	if emailRegexp.MatchString(field) {
 
 		if e.allowDisposableDomains == false {
 
-			// is the domain a throwaway one?
-
-			// extract the domain from the e-mail address
-			d := strings.LastIndex(field, "@")
-			domain := field[d+1:]
-
-			// it is in the list, bad e-mail address
-			if inSlice(disposableDomains, domain) {
-				return errors.New(errorMessages["email"]), nil
-			}
-
-			// wildcard list
-			if inSlice(disposableWildcards, domain) {
+			// is the domain a throwaway one? subdomains of the wildcard list count too
+			_, domain, _ := splitEmail(field)
+			if _, ok := matchDisposable(domain); ok {
 				return errors.New(errorMessages["email"]), nil
 			}
 		}
@@ -473,3 +461,50 @@ func (e *email) Validate(fields []string, errorMessages map[string]string) (erro
 
 	return errors.New(errorMessages["email"]), nil
 }
+
+// -----------------------
+
+type regex struct {
+	re         *regexp.Regexp
+	err        error
+	messageKey string
+}
+
+/*
+	Entries must match a regular expression (package "regexp" syntax), errors use the message MESSAGEKEY
+	The pattern is compiled once, a bad pattern is returned by New() (see Checker), not found when a form is submitted.
+	Anchor the pattern to match the whole entry.
+
+	Ex: Regex(`^[A-Z]{3}-[0-9]{4}$`, "order_number") with validator.SetErrors() for the message
+*/
+func Regex(pattern, messageKey string) Rule {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		err = fmt.Errorf("Regex(%q): %w", pattern, err)
+	}
+	return &regex{re, err, messageKey}
+}
+
+func (r *regex) Check() error {
+	return r.err
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (r *regex) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	// a bad pattern rejects everything, if the rule was not checked by New()
+	if r.err == nil && r.re.MatchString(field) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages[r.messageKey]), nil
+}
@@ -342,14 +342,17 @@ func Test_creditCard(t *testing.T) {
 		{"4242424242424242", false},
 		{"", true},
 		{"4.716461583322103", false},
-		{" 4 7 164 61 583 322 10 3", false},
-		{"4716 4615 8332 2103", false},
+		{" 4 7 164 61 583 322 10 3", true},
+		{"4716 4615 8332 2103", true},
 		{"blahblah", false},
 		{"-5398228707871527", false},
 		{"-5398228707871527-", false},
-		{"5398-2287-0787-1527", false},
+		{"5398-2287-0787-1527", true},
 		{"-38.4716461583322103", false},
 		{"5398228707871528", false},
+		{"5398--2287-0787-1527", false},
+		{"5398 2287 0787 1527 ", true},
+		{"4242 4242 4242 4242", false},
 		{"375556917985515", true},
 		{"36050234196908", true},
 		{"4716461583322103", true},
@@ -533,3 +536,34 @@ func Test_email(t *testing.T) {
 		}
 	}
 }
+
+func Test_regex(t *testing.T) {
+	var list = []struct {
+		pattern string
+		field   string
+		key     string
+	}{
+		{`^[A-Z]{3}-[0-9]{4}$`, "", ""},
+		{`^[A-Z]{3}-[0-9]{4}$`, "ABC-1234", ""},
+		{`^[A-Z]{3}-[0-9]{4}$`, "abc-1234", "order_number"},
+		{`^[A-Z]{3}-[0-9]{4}$`, "ABC-12345", "order_number"},
+		{`[0-9]`, "abc1", ""}, // not anchored
+		{`^[A-Z`, "A", "order_number"},
+	}
+
+	messages := map[string]string{"order_number": "order_number"} // a custom message, see SetErrors()
+	for _, l := range list {
+		e, _ := Regex(l.pattern, "order_number").Validate([]string{l.field}, messages)
+		if key := messageKey(e); key != l.key {
+			t.Errorf("Regex(%s, %s): Error[%s]. Expected: %s", l.pattern, l.field, key, l.key)
+		}
+	}
+
+	if err := Regex(`^[A-Z`, "order_number").(Checker).Check(); err == nil {
+		t.Errorf("Regex(^[A-Z).Check(): no error")
+	}
+
+	if err := Regex(`^[A-Z]$`, "order_number").(Checker).Check(); err != nil {
+		t.Errorf("Regex(^[A-Z]$).Check(): %s", err.Error())
+	}
+}
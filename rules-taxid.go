@@ -0,0 +1,499 @@
+package formvalidator
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/dholtzmann/formvalidator/checkdigit"
+)
+
+// separators people type in identifiers: "529.982.247-25", "11.222.333/0001-81", "DE 136 695 976"
+var taxIDReplacer = strings.NewReplacer(" ", "", "-", "", ".", "", "/", "")
+
+// upper case without separators
+func compactTaxID(s string) string {
+	return taxIDReplacer.Replace(strings.ToUpper(strings.TrimSpace(s)))
+}
+
+// sum of digits multiplied by weights, the string must have at least len(weights) digits
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for n, w := range weights {
+		sum += int(digits[n]-'0') * w
+	}
+	return sum
+}
+
+// "11111111111" passes most mod 11 checks
+func isRepeatedDigit(s string) bool {
+	return strings.Count(s, s[:1]) == len(s)
+}
+
+// Brazilian individual taxpayer number, 11 digits with two mod 11 check digits
+func isValidCPF(s string) bool {
+	if len(s) != 11 || !isDigits(s) || isRepeatedDigit(s) {
+		return false
+	}
+
+	for n := 9; n <= 10; n++ {
+		check := weightedSum(s, cpfWeights[10-n:]) * 10 % 11 % 10
+		if check != int(s[n]-'0') {
+			return false
+		}
+	}
+	return true
+}
+
+var cpfWeights = []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// Brazilian company number, 14 digits with two mod 11 check digits
+func isValidCNPJ(s string) bool {
+	if len(s) != 14 || !isDigits(s) || isRepeatedDigit(s) {
+		return false
+	}
+
+	for n := 12; n <= 13; n++ {
+		check := 11 - weightedSum(s, cnpjWeights[13-n:])%11
+		if check >= 10 {
+			check = 0
+		}
+		if check != int(s[n]-'0') {
+			return false
+		}
+	}
+	return true
+}
+
+var cnpjWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// US Social Security number, 9 digits, area 001-899 except 666, group and serial not zero (there is no check digit)
+func isValidSSN(s string) bool {
+	if len(s) != 9 || !isDigits(s) {
+		return false
+	}
+
+	area, group, serial := s[:3], s[3:5], s[5:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// US Employer Identification number, 9 digits with an assigned campus prefix
+func isValidEIN(s string) bool {
+	return len(s) == 9 && isDigits(s) && !inSlice(einUnassignedPrefixes, s[:2])
+}
+
+var einUnassignedPrefixes = []string{"00", "07", "08", "09", "17", "18", "19", "28", "29", "49", "69", "70", "78", "79", "89", "96", "97"}
+
+// -----------------------
+
+/*
+	EU VAT numbers without the country prefix, by lowercase ISO 3166 code
+	Each function checks the structure and the check digits of the national scheme.
+*/
+var vatSchemes = map[string]func(string) bool{
+	"at": isValidVATAT,
+	"be": isValidVATBE,
+	"de": isValidVATDE,
+	"dk": isValidVATDK,
+	"es": isValidNIF,
+	"fi": isValidVATFI,
+	"fr": isValidVATFR,
+	"it": isValidVATIT,
+	"lu": isValidVATLU,
+	"nl": isValidVATNL,
+	"pl": isValidVATPL,
+	"pt": isValidVATPT,
+	"se": isValidVATSE,
+}
+
+// "U" and 8 digits
+func isValidVATAT(s string) bool {
+	if len(s) != 9 || s[0] != 'U' || !isDigits(s[1:]) {
+		return false
+	}
+
+	sum := 0
+	for n := 0; n < 7; n++ {
+		d := int(s[n+1] - '0')
+		if n%2 == 1 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+
+	return (10-(sum+4)%10)%10 == int(s[8]-'0')
+}
+
+// 10 digits starting with 0 or 1 (older numbers have 9 digits, without the 0)
+func isValidVATBE(s string) bool {
+	if len(s) == 9 {
+		s = "0" + s
+	}
+	if len(s) != 10 || !isDigits(s) || s[0] > '1' {
+		return false
+	}
+
+	n, _ := strconv.Atoi(s[:8])
+	check, _ := strconv.Atoi(s[8:])
+	return 97-n%97 == check
+}
+
+// 9 digits, ISO 7064 MOD 11,10
+func isValidVATDE(s string) bool {
+	return len(s) == 9 && s[0] != '0' && checkdigit.Mod11_10.Verify(s)
+}
+
+// 8 digits, weighted sum divisible by 11
+func isValidVATDK(s string) bool {
+	return len(s) == 8 && isDigits(s) && s[0] != '0' && weightedSum(s, []int{2, 7, 6, 5, 4, 3, 2, 1})%11 == 0
+}
+
+// 8 digits, mod 11 check digit
+func isValidVATFI(s string) bool {
+	if len(s) != 8 || !isDigits(s) {
+		return false
+	}
+
+	check := (11 - weightedSum(s, []int{7, 9, 10, 5, 8, 4, 2})%11) % 11
+	return check != 10 && check == int(s[7]-'0')
+}
+
+// 2 character key and the 9 digit SIREN, numeric keys are checked against the SIREN
+func isValidVATFR(s string) bool {
+	if len(s) != 11 || !isDigits(s[2:]) {
+		return false
+	}
+
+	key, siren := s[:2], s[2:]
+	if !isDigits(key) { // I and O are not used
+		return isUpperAlnum(key) && !strings.ContainsAny(key, "IO")
+	}
+
+	k, _ := strconv.Atoi(key)
+	n, _ := strconv.Atoi(siren)
+	return (12+3*(n%97))%97 == k
+}
+
+// 11 digits, Luhn
+func isValidVATIT(s string) bool {
+	if len(s) != 11 || !isDigits(s) || s[:7] == "0000000" {
+		return false
+	}
+
+	return checkdigit.Luhn.Verify(s)
+}
+
+// 8 digits, the first 6 mod 89 are the last 2
+func isValidVATLU(s string) bool {
+	if len(s) != 8 || !isDigits(s) {
+		return false
+	}
+
+	n, _ := strconv.Atoi(s[:6])
+	check, _ := strconv.Atoi(s[6:])
+	return n%89 == check
+}
+
+// 9 digits, "B" and 2 digits, mod 11 (older numbers) or mod 97 over "NL" and the number (sole proprietors since 2020)
+func isValidVATNL(s string) bool {
+	if len(s) != 12 || s[9] != 'B' || !isDigits(s[:9]) || !isDigits(s[10:]) {
+		return false
+	}
+
+	if (weightedSum(s, []int{9, 8, 7, 6, 5, 4, 3, 2})-int(s[8]-'0'))%11 == 0 {
+		return true
+	}
+
+	// letters count as 10-35, like an IBAN: N=23, L=21, B=11
+	return checkdigit.Mod97_10.Verify("NL" + s)
+}
+
+// 10 digits, mod 11 check digit
+func isValidVATPL(s string) bool {
+	if len(s) != 10 || !isDigits(s) {
+		return false
+	}
+
+	return weightedSum(s, []int{6, 5, 7, 2, 3, 4, 5, 6, 7})%11 == int(s[9]-'0')
+}
+
+// 9 digits, mod 11 check digit
+func isValidVATPT(s string) bool {
+	if len(s) != 9 || !isDigits(s) || strings.IndexByte("1235689", s[0]) < 0 {
+		return false
+	}
+
+	check := 11 - weightedSum(s, []int{9, 8, 7, 6, 5, 4, 3, 2})%11
+	if check >= 10 {
+		check = 0
+	}
+	return check == int(s[8]-'0')
+}
+
+// 10 digit organisation number (Luhn) and "01"
+func isValidVATSE(s string) bool {
+	return len(s) == 12 && isDigits(s) && strings.HasSuffix(s, "01") && checkdigit.Luhn.Verify(s[:10])
+}
+
+const nifLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+/*
+	Spanish tax number (NIF), 9 characters
+	DNI: 8 digits and a check letter "12345678Z"
+	NIE: X, Y or Z, 7 digits and a check letter "X1234567L"
+	CIF: organisation letter, 7 digits and a check digit or letter "A58818501"
+*/
+func isValidNIF(s string) bool {
+	if len(s) != 9 {
+		return false
+	}
+
+	last := s[8]
+
+	// DNI and NIE
+	if nie := strings.IndexByte("XYZ", s[0]); isDigits(s[:8]) || (nie >= 0 && isDigits(s[1:8])) {
+		if nie >= 0 {
+			s = strconv.Itoa(nie) + s[1:]
+		}
+		n, _ := strconv.Atoi(s[:8])
+		return nifLetters[n%23] == last
+	}
+
+	// CIF, and NIF for individuals without a DNI (K, L, M)
+	if strings.IndexByte("ABCDEFGHJKLMNPQRSUVW", s[0]) < 0 || !isDigits(s[1:8]) {
+		return false
+	}
+
+	sum := 0
+	for n := 1; n < 8; n++ {
+		d := int(s[n] - '0')
+		if n%2 == 1 {
+			d = d*2/10 + d*2%10
+		}
+		sum += d
+	}
+	check := (10 - sum%10) % 10
+
+	switch {
+	case strings.IndexByte("KLMNPQRSW", s[0]) >= 0: // letter only
+		return last == "JABCDEFGHI"[check]
+	case strings.IndexByte("ABEH", s[0]) >= 0: // digit only
+		return int(last-'0') == check
+	default:
+		return last == "JABCDEFGHI"[check] || int(last-'0') == check
+	}
+}
+
+// [0-9A-Z] only
+func isUpperAlnum(s string) bool {
+	for _, c := range s {
+		if !(c >= '0' && c <= '9' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// -----------------------
+
+type cpf struct{}
+
+/*
+	Brazilian individual taxpayer number (Cadastro de Pessoas Físicas), check digits verified
+	Format: "529.982.247-25" or 11 digits, normalized to digits only, see Normalizer
+*/
+func CPF() Rule {
+	return &cpf{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (c *cpf) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if isValidCPF(compactTaxID(field)) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["cpf"]), nil
+}
+
+func (c *cpf) Normalize(field string) string {
+	return compactTaxID(field)
+}
+
+// -----------------------
+
+type cnpj struct{}
+
+/*
+	Brazilian company number (Cadastro Nacional da Pessoa Jurídica), check digits verified
+	Format: "11.222.333/0001-81" or 14 digits, normalized to digits only, see Normalizer
+*/
+func CNPJ() Rule {
+	return &cnpj{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (c *cnpj) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if isValidCNPJ(compactTaxID(field)) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["cnpj"]), nil
+}
+
+func (c *cnpj) Normalize(field string) string {
+	return compactTaxID(field)
+}
+
+// -----------------------
+
+type ssn struct{}
+
+/*
+	US Social Security number, there is no check digit, so only the number ranges that are never assigned are rejected
+	Format: "123-45-6789" or 9 digits, normalized to digits only, see Normalizer
+*/
+func SSN() Rule {
+	return &ssn{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (s *ssn) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if isValidSSN(compactTaxID(field)) {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["ssn"]), nil
+}
+
+func (s *ssn) Normalize(field string) string {
+	return compactTaxID(field)
+}
+
+// -----------------------
+
+type vatNumber struct{}
+
+/*
+	EU VAT number with its country prefix, check digits verified
+	Supported: AT, BE, DE, DK, ES, FI, FR, IT, LU, NL, PL, PT, SE, other prefixes are rejected
+	Format: "DE 136 695 976", normalized to "DE136695976", see Normalizer
+*/
+func VATNumber() Rule {
+	return &vatNumber{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (v *vatNumber) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if vat := compactTaxID(field); len(vat) > 2 {
+		if valid, ok := vatSchemes[strings.ToLower(vat[:2])]; ok && valid(vat[2:]) {
+			return nil, nil
+		}
+	}
+
+	return errors.New(errorMessages["vat_number"]), nil
+}
+
+func (v *vatNumber) Normalize(field string) string {
+	return compactTaxID(field)
+}
+
+// -----------------------
+
+type taxID struct {
+	country string
+}
+
+/*
+	Tax identification number for the selected country (lowercase ISO 3166, same as CountryCode())
+	The country can come from another field: TaxID(form.Get("Country"))
+
+	br - CPF or CNPJ
+	us - SSN or EIN
+	es - NIF (DNI, NIE or CIF)
+	at, be, de, dk, fi, fr, it, lu, nl, pl, pt, se - VAT number, the country prefix is optional
+	Other countries only need 4-20 letters or digits, there is no scheme to check.
+
+	The entry is normalized to upper case without separators, see Normalizer
+*/
+func TaxID(country string) Rule {
+	return &taxID{strings.ToLower(country)}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (t *taxID) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	id := compactTaxID(field)
+
+	var valid bool
+	switch t.country {
+	case "br":
+		valid = isValidCPF(id) || isValidCNPJ(id)
+	case "us":
+		valid = isValidSSN(id) || isValidEIN(id)
+	default:
+		if scheme, ok := vatSchemes[t.country]; ok {
+			valid = scheme(strings.TrimPrefix(id, strings.ToUpper(t.country)))
+		} else {
+			valid = len(id) >= 4 && len(id) <= 20 && isUpperAlnum(id)
+		}
+	}
+
+	if valid {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["tax_id"]), nil
+}
+
+func (t *taxID) Normalize(field string) string {
+	return compactTaxID(field)
+}
@@ -0,0 +1,172 @@
+package formvalidator
+
+import (
+	"testing"
+)
+
+func Test_cpfCNPJ(t *testing.T) {
+	var list = []struct {
+		field       string
+		rule        Rule
+		expectation bool
+		normalized  string
+	}{
+		{"", CPF(), true, ""},
+		{"529.982.247-25", CPF(), true, "52998224725"},
+		{"52998224725", CPF(), true, "52998224725"},
+		{"529.982.247-24", CPF(), false, ""},
+		{"111.111.111-11", CPF(), false, ""}, // passes the check digits, never issued
+		{"529.982.247", CPF(), false, ""},
+		{"", CNPJ(), true, ""},
+		{"11.222.333/0001-81", CNPJ(), true, "11222333000181"},
+		{"11222333000181", CNPJ(), true, "11222333000181"},
+		{"11.222.333/0001-82", CNPJ(), false, ""},
+		{"00.000.000/0000-00", CNPJ(), false, ""},
+		{"11.222.333/0001", CNPJ(), false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := l.rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("cpfCNPJ(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := l.rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("cpfCNPJ(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_ssn(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+	}{
+		{"", true},
+		{"123-45-6789", true},
+		{"123456789", true},
+		{"000-45-6789", false},
+		{"666-45-6789", false},
+		{"900-45-6789", false},
+		{"123-00-6789", false},
+		{"123-45-0000", false},
+		{"123-45-678", false},
+		{"123-45-678A", false},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := SSN().Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("ssn(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+	}
+}
+
+func Test_vatNumber(t *testing.T) {
+	var list = []struct {
+		field       string
+		expectation bool
+	}{
+		{"", true},
+		{"ATU13585627", true},
+		{"BE0403170701", true},
+		{"BE 403.170.701", true}, // older 9 digit format
+		{"DE136695976", true},
+		{"de 136 695 976", true},
+		{"DK13585628", true},
+		{"ESA58818501", true}, // CIF
+		{"ES12345678Z", true}, // DNI
+		{"ESX1234567L", true}, // NIE
+		{"FI20774740", true},
+		{"FR40303265045", true},
+		{"FRA0303265045", true}, // new style key, not checked against the SIREN
+		{"IT00743110157", true},
+		{"LU15027442", true},
+		{"NL004495445B01", true},
+		{"NL000099998B57", true}, // mod 97
+		{"PL5260250274", true},
+		{"PT501964843", true},
+		{"SE556188840401", true},
+		{"ATU13585626", false},
+		{"BE0403170702", false},
+		{"BE2403170701", false},
+		{"DE136695977", false},
+		{"DE036695976", false},
+		{"DK13585629", false},
+		{"ESA58818502", false},
+		{"ES12345678A", false},
+		{"FI20774741", false},
+		{"FR41303265045", false},
+		{"FRIO303265045", false},
+		{"IT00743110158", false},
+		{"LU15027443", false},
+		{"NL004495446B01", false},
+		{"NL004495445A01", false},
+		{"PL5260250275", false},
+		{"PT401964843", false},
+		{"SE556188840402", false},
+		{"GB980780684", false}, // not supported
+		{"136695976", false},   // prefix is required
+		{"DE", false},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := VATNumber().Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("vatNumber(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+	}
+}
+
+func Test_taxID(t *testing.T) {
+	var list = []struct {
+		field       string
+		country     string
+		expectation bool
+	}{
+		{"", "br", true},
+		{"529.982.247-25", "br", true},
+		{"11.222.333/0001-81", "BR", true},
+		{"529.982.247-24", "br", false},
+		{"123-45-6789", "us", true},
+		{"12-3456789", "us", true}, // EIN
+		{"00-0012345", "us", false},
+		{"12345678Z", "es", true},
+		{"136695976", "de", true},
+		{"DE136695976", "de", true},
+		{"FR40303265045", "de", false}, // prefix of another country
+		{"136695977", "de", false},
+		{"AB123456C", "gb", true}, // no scheme
+		{"AB1", "gb", false},
+		{"AB#123456C", "gb", false},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := TaxID(l.country).Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("taxID(%s, %s): Valid[%t]. Expected: %t", l.field, l.country, valid, l.expectation)
+		}
+	}
+}
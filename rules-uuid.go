@@ -0,0 +1,259 @@
+package formvalidator
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// letter case of the hex digits in a UUID, see UUIDOptions
+type UUIDCase int
+
+const (
+	UUIDAnyCase UUIDCase = iota
+	UUIDLowerCase
+	UUIDUpperCase
+)
+
+/*
+	Options for UUID()
+
+	Versions - accepted versions (1-8), nil accepts all of them
+	AllowNil, AllowMax - the nil UUID (all zeros) and the max UUID (all ones), they have no version
+	AnyVariant - also accept variants other than the one of RFC 9562 (10xx, the digit after the third hyphen is 8, 9, a or b)
+	Case - UUIDAnyCase, UUIDLowerCase or UUIDUpperCase
+	AllowBraces - "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", the way Microsoft writes GUIDs
+	AllowURN - "urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6"
+*/
+type UUIDOptions struct {
+	Versions    []int
+	AllowNil    bool
+	AllowMax    bool
+	AnyVariant  bool
+	Case        UUIDCase
+	AllowBraces bool
+	AllowURN    bool
+}
+
+var ErrInvalidUUID = errors.New("String is not a valid UUID!")
+
+var (
+	uuidNil = [16]byte{}
+	uuidMax = [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+// parse the hyphenated form "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", optionally wrapped in braces or an URN
+func parseUUID(s string, o UUIDOptions) ([16]byte, error) {
+	var u [16]byte
+
+	if o.AllowURN && len(s) > 9 && strings.EqualFold(s[:9], "urn:uuid:") {
+		s = s[9:]
+	} else if o.AllowBraces && len(s) == 38 && s[0] == '{' && s[37] == '}' {
+		s = s[1:37]
+	}
+
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, ErrInvalidUUID
+	}
+
+	switch {
+	case o.Case == UUIDLowerCase && s != strings.ToLower(s),
+		o.Case == UUIDUpperCase && s != strings.ToUpper(s):
+		return u, ErrInvalidUUID
+	}
+
+	if _, err := hex.Decode(u[:], []byte(s[:8]+s[9:13]+s[14:18]+s[19:23]+s[24:])); err != nil {
+		return u, ErrInvalidUUID
+	}
+
+	if u == uuidNil || u == uuidMax {
+		if (u == uuidNil && o.AllowNil) || (u == uuidMax && o.AllowMax) {
+			return u, nil
+		}
+		return u, ErrInvalidUUID
+	}
+
+	if !o.AnyVariant && u[8]&0xc0 != 0x80 {
+		return u, ErrInvalidUUID
+	}
+
+	version := int(u[6] >> 4)
+	if len(o.Versions) == 0 {
+		if version < 1 || version > 8 {
+			return u, ErrInvalidUUID
+		}
+		return u, nil
+	}
+
+	for _, v := range o.Versions {
+		if v == version {
+			return u, nil
+		}
+	}
+
+	return u, ErrInvalidUUID
+}
+
+// -----------------------
+
+type uuid struct {
+	options UUIDOptions
+}
+
+/*
+	UUID - universally unique id, used in databases, software, ... (RFC 9562)
+	Ex: UUID(UUIDOptions{Versions: []int{4, 7}}) for random and time-ordered UUIDs
+	The entry is normalized to the lower case hyphenated form, without braces or URN, see Normalizer
+*/
+func UUID(options UUIDOptions) Rule {
+	return &uuid{options}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (i *uuid) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if _, err := parseUUID(field, i.options); err == nil {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["uuid"]), nil
+}
+
+func (i *uuid) Normalize(field string) string {
+	u, err := parseUUID(field, i.options)
+	if err != nil {
+		return field
+	}
+
+	h := hex.EncodeToString(u[:])
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
+
+// -----------------------
+
+type isUUID struct {
+	options UUIDOptions
+}
+
+/*
+	VERSION 3, 4 or 5, any other number accepts all UUIDs in the hyphenated form, whatever the version
+	Upper and lower case are accepted, the entry is not normalized, see UUID() for more options
+*/
+func IsUUID(version uint32) Rule {
+	switch version {
+	case 3: // any variant, like the regular expression from 'govalidator' this rule used to have
+		return &isUUID{UUIDOptions{Versions: []int{3}, AnyVariant: true}}
+	case 4, 5:
+		return &isUUID{UUIDOptions{Versions: []int{int(version)}}}
+	}
+
+	return &isUUID{UUIDOptions{Versions: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, AllowNil: true, AllowMax: true, AnyVariant: true}}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (i *isUUID) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	if _, err := parseUUID(field, i.options); err == nil {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["uuid"]), nil
+}
+
+// -----------------------
+
+// Crockford's base32, the alphabet of ULIDs
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+type ulid struct {
+}
+
+/*
+	ULID - 26 characters of Crockford's base32, a 48 bit timestamp and 80 random bits, sortable by time
+	Case insensitive, the entry is normalized to upper case, see Normalizer
+*/
+func ULID() Rule {
+	return &ulid{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (u *ulid) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	field = strings.ToUpper(field)
+
+	// 26 characters hold 130 bits, the first one can only be 0-7 for 128
+	if len(field) == 26 && field[0] <= '7' && strings.Trim(field, crockfordBase32) == "" {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["ulid"]), nil
+}
+
+func (u *ulid) Normalize(field string) string {
+	return strings.ToUpper(field)
+}
+
+// -----------------------
+
+const base62 = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// largest KSUID, 2^160 - 1
+const ksuidMax = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+type ksuid struct {
+}
+
+/*
+	KSUID - 27 characters of base62, a 32 bit timestamp and 128 random bits, sortable by time
+	Case sensitive
+*/
+func KSUID() Rule {
+	return &ksuid{}
+}
+
+/*
+	return error - FormError with message and extra data if revelant
+*/
+func (k *ksuid) Validate(fields []string, errorMessages map[string]string) (error, []interface{}) {
+
+	field := getFirstKey(fields)
+
+	// if blank, it is fine
+	if len(field) == 0 {
+		return nil, nil
+	}
+
+	// the alphabet is in ASCII order, so KSUIDs of the same length compare like the numbers they stand for
+	if len(field) == 27 && strings.Trim(field, base62) == "" && field <= ksuidMax {
+		return nil, nil
+	}
+
+	return errors.New(errorMessages["ksuid"]), nil
+}
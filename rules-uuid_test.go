@@ -0,0 +1,155 @@
+package formvalidator
+
+import (
+	"testing"
+)
+
+func Test_isUUID(t *testing.T) {
+	var list = []struct {
+		field       string
+		version     uint32
+		expectation bool
+	}{
+		{"", 0, true}, // unknown version
+		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 0, false},
+		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3xxx", 0, false},
+		{"a987fbc94bed3078cf079141ba07c9f3", 0, false},
+		{"934859", 0, false},
+		{"987fbc9-4bed-3078-cf07a-9141ba07c9f3", 0, false},
+		{"aaaaaaaa-1111-1111-aaag-111111111111", 0, false},
+		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", 0, true},
+
+		{"", 3, true}, // v3
+		{"412452646", 3, false},
+		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 3, false},
+		{"a987fbc9-4bed-4078-8f07-9141ba07c9f3", 3, false},
+		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", 3, true},
+
+		{"", 4, true}, // v4
+		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 4, false},
+		{"a987fbc9-4bed-5078-af07-9141ba07c9f3", 4, false},
+		{"934859", 4, false},
+		{"57b73598-8764-4ad0-a76a-679bb6640eb1", 4, true},
+		{"625e63f3-58f5-40b7-83a1-a72ad31acffb", 4, true},
+
+		{"", 5, true}, // v5
+		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", 5, false},
+		{"9c858901-8a57-4791-81fe-4c455b099bc9", 5, false},
+		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", 5, false},
+		{"987fbc97-4bed-5078-af07-9141ba07c9f3", 5, true},
+		{"987fbc97-4bed-5078-9f07-9141ba07c9f3", 5, true},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = IsUUID(l.version)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("isUUID(%d, '%s'): Valid[%t]. Expected: %t", l.version, l.field, valid, l.expectation)
+		}
+	}
+}
+
+func Test_isUUIDCase(t *testing.T) {
+	if e, _ := IsUUID(4).Validate([]string{"57B73598-8764-4AD0-A76A-679BB6640EB1"}, make(map[string]string)); e != nil {
+		t.Errorf("isUUID(4, upper case): Valid[false]. Expected: true")
+	}
+}
+
+func Test_uuid(t *testing.T) {
+	var list = []struct {
+		field       string
+		options     UUIDOptions
+		expectation bool
+		normalized  string
+	}{
+		{"", UUIDOptions{}, true, ""},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf6", UUIDOptions{}, true, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"}, // v1
+		{"000003e8-7dec-21d0-a700-00a0c91e6bf6", UUIDOptions{}, true, "000003e8-7dec-21d0-a700-00a0c91e6bf6"}, // v2
+		{"1EC9414C-232A-6B00-B3C8-9E6BDECED846", UUIDOptions{}, true, "1ec9414c-232a-6b00-b3c8-9e6bdeced846"}, // v6
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", UUIDOptions{}, true, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"}, // v7
+		{"320c3d4d-cc00-875b-8ec9-32d5f69181c0", UUIDOptions{}, true, "320c3d4d-cc00-875b-8ec9-32d5f69181c0"}, // v8
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", UUIDOptions{Versions: []int{4, 7}}, true, "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf6", UUIDOptions{Versions: []int{4, 7}}, false, ""},
+		{"f81d4fae-7dec-91d0-a765-00a0c91e6bf6", UUIDOptions{}, false, ""}, // v9 is not defined
+		{"f81d4fae-7dec-11d0-c765-00a0c91e6bf6", UUIDOptions{}, false, ""}, // Microsoft variant
+		{"f81d4fae-7dec-11d0-c765-00a0c91e6bf6", UUIDOptions{AnyVariant: true}, true, "f81d4fae-7dec-11d0-c765-00a0c91e6bf6"},
+		{"00000000-0000-0000-0000-000000000000", UUIDOptions{}, false, ""},
+		{"00000000-0000-0000-0000-000000000000", UUIDOptions{AllowNil: true}, true, "00000000-0000-0000-0000-000000000000"},
+		{"FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF", UUIDOptions{AllowMax: true}, true, "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+		{"FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF", UUIDOptions{AllowNil: true}, false, ""},
+		{"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", UUIDOptions{Case: UUIDLowerCase}, false, ""},
+		{"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", UUIDOptions{Case: UUIDUpperCase}, true, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf6", UUIDOptions{Case: UUIDUpperCase}, false, ""},
+		{"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", UUIDOptions{}, false, ""},
+		{"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", UUIDOptions{AllowBraces: true}, true, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+		{"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6", UUIDOptions{AllowBraces: true}, false, ""},
+		{"urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", UUIDOptions{}, false, ""},
+		{"URN:UUID:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", UUIDOptions{AllowURN: true}, true, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"},
+		{"f81d4fae7dec11d0a76500a0c91e6bf6", UUIDOptions{}, false, ""},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bfg", UUIDOptions{}, false, ""},
+		{"f81d4fae-7dec-11d0-a765+00a0c91e6bf6", UUIDOptions{}, false, ""},
+	}
+
+	for _, l := range list {
+		valid := false
+		var rule Rule = UUID(l.options)
+
+		if e, _ := rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("uuid(%s, %+v): Valid[%t]. Expected: %t", l.field, l.options, valid, l.expectation)
+		}
+
+		if valid && len(l.field) > 0 {
+			if n := rule.(Normalizer).Normalize(l.field); n != l.normalized {
+				t.Errorf("uuid(%s): Normalized[%s]. Expected: %s", l.field, n, l.normalized)
+			}
+		}
+	}
+}
+
+func Test_ulidKSUID(t *testing.T) {
+	var list = []struct {
+		field       string
+		rule        Rule
+		expectation bool
+	}{
+		{"", ULID(), true},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", ULID(), true},
+		{"01arz3ndektsv4rrffq69g5fav", ULID(), true},
+		{"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", ULID(), true},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", ULID(), false}, // more than 128 bits
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAU", ULID(), false}, // U is not in the alphabet
+		{"01ARZ3NDEKTSV4RRFFQ69G5FA", ULID(), false},
+		{"", KSUID(), true},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", KSUID(), true},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80V", KSUID(), true},
+		{"aWgEPTl1tmebfsQzFP4bxwgy80W", KSUID(), false}, // more than 160 bits
+		{"zzzzzzzzzzzzzzzzzzzzzzzzzzz", KSUID(), false},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO", KSUID(), false},
+		{"0ujtsYcgvSTl8PAuAdqWYSMnLO-", KSUID(), false},
+	}
+
+	for _, l := range list {
+		valid := false
+
+		if e, _ := l.rule.Validate([]string{l.field}, make(map[string]string)); e == nil {
+			valid = true
+		}
+
+		if l.expectation != valid {
+			t.Errorf("ulidKSUID(%s): Valid[%t]. Expected: %t", l.field, valid, l.expectation)
+		}
+	}
+
+	if n := ULID().(Normalizer).Normalize("01arz3ndektsv4rrffq69g5fav"); n != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Errorf("ulid(01arz3ndektsv4rrffq69g5fav): Normalized[%s]. Expected: 01ARZ3NDEKTSV4RRFFQ69G5FAV", n)
+	}
+}
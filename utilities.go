@@ -3,7 +3,9 @@ package formvalidator
 import (
 	"bytes"
 	"encoding/csv"
+	"errors"
 	"fmt"
+	"strings"
 )
 
 /*
@@ -13,21 +15,60 @@ import (
 
 	Example:
 
-	var e = FormError{"Integer must be between %d and %d", []interface{}{5, 10}}
+	var e = FormError{Str: "Integer must be between %d and %d", Data: []interface{}{5, 10}}
 	i18n(e.Str, e.Data) -> "Escriba Usted un valor entre 5 y 10"
+
+	Warning is set for soft errors, like "Did you mean bob@gmail.com?", they do not make the form invalid or blank the field.
+	Locale is set by FormValidator.Validate, numbers and dates in 'Data' are written for it (see Locale.Sprintf()), nil uses fmt.Sprintf
 */
 type FormError struct {
-	Str  string
-	Data []interface{}
+	Str     string
+	Data    []interface{}
+	Warning bool
+	Locale  *Locale
 }
 
 func (e *FormError) Error() string {
 	if len(e.Data) > 0 { // if 'Data' is not empty format the string
+		if e.Locale != nil {
+			return e.Locale.Sprintf(e.Str, e.Data...)
+		}
 		return fmt.Sprintf(e.Str, e.Data...)
 	}
 	return e.Str
 }
 
+/*
+	A rule returns a Warning instead of an error when the entry is valid, but probably a mistake
+	FormValidator.Validate reports it as a FormError with Warning set, see NewWarning()
+*/
+type Warning struct {
+	Str string
+}
+
+func (w *Warning) Error() string {
+	return w.Str
+}
+
+func NewWarning(msg string) error {
+	return &Warning{msg}
+}
+
+func isWarning(err error) bool {
+	var w *Warning
+	return errors.As(err, &w)
+}
+
+// are there errors besides warnings?
+func hasErrors(errs []FormError) bool {
+	for _, e := range errs {
+		if !e.Warning {
+			return true
+		}
+	}
+	return false
+}
+
 // appendError(err, errors...) do not forget the dots for slices
 func appendError(err []FormError, arg ...*FormError) []FormError {
 	for _, e := range arg {
@@ -46,6 +87,22 @@ func inSlice(slice []string, val string) bool {
 	return false
 }
 
+// a set of strings for lookups in lists that are built once, see newStringSet()
+type stringSet map[string]struct{}
+
+func newStringSet(list []string) stringSet {
+	set := make(stringSet, len(list))
+	for _, v := range list {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+func (s stringSet) has(val string) bool {
+	_, ok := s[val]
+	return ok
+}
+
 // check for duplicate entries in a slice
 func hasDuplicates(slice []string) bool {
 	encounteredItems := make(map[string]bool)
@@ -99,3 +156,36 @@ func getFirstKey(values []string) string {
 
 	return ""
 }
+
+// separators in card numbers, ISBNs and barcodes: "978-0-306-40615-7", "4242 4242 4242 4242"
+var separatorReplacer = strings.NewReplacer(" ", "", "-", "")
+
+/*
+	Optimal string alignment distance, the number of insertions, deletions, substitutions and swaps of adjacent characters
+	"gmial.com" -> "gmail.com" is 1, compares bytes, for ASCII domain names
+*/
+func editDistance(a, b string) int {
+	// three rows of the matrix are enough, the swap looks two rows back
+	prev2, prev, cur := make([]int, len(b)+1), make([]int, len(b)+1), make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+
+	return prev[len(b)]
+}
@@ -6,8 +6,8 @@ import (
 
 func Test_appendError(t *testing.T) {
 	var a []FormError
-	b := &FormError{"Hello", []interface{}{1, 2}}
-	c := &FormError{"Goodbye", []interface{}{3, 4}}
+	b := &FormError{Str: "Hello", Data: []interface{}{1, 2}}
+	c := &FormError{Str: "Goodbye", Data: []interface{}{3, 4}}
 	a = appendError(a, b, c)
 	if len(a) != 2 {
 		t.Errorf("appendError(): Should return a slice with length of 2!")
@@ -105,3 +105,32 @@ func Test_getFirstKey(t *testing.T) {
 		t.Errorf("getFirstKey(): Did not return the first value of the provided slice! Return[%s]", getFirstKey(list1))
 	}
 }
+
+func Test_formErrorLocale(t *testing.T) {
+	e := &FormError{Str: "This field must be between %f - %f.", Data: []interface{}{0.01, 100.0}}
+	if e.Error() != "This field must be between 0.010000 - 100.000000." {
+		t.Errorf("FormError.Error(): Without a locale the data should use fmt.Sprintf! [%s]", e.Error())
+	}
+
+	e.Locale = LocaleDeDE
+	if e.Error() != "This field must be between 0,01 - 100." {
+		t.Errorf("FormError.Error(): Data was not formatted for the locale! [%s]", e.Error())
+	}
+}
+
+// the default error messages replaced by their keys, so a test can check which message a rule returned
+var testMessages = func() map[string]string {
+	_, v := New(map[string][]Rule{})
+	messages := make(map[string]string)
+	for key := range v.errorMessages {
+		messages[key] = key
+	}
+	return messages
+}()
+
+func messageKey(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
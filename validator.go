@@ -2,22 +2,24 @@ package formvalidator
 
 import (
 	"errors"
+	"fmt"
 	"net/url"
+	"sort"
 )
 
-// global variables
-var iso3166countryCodes []string
-var iso4217currencyCodes []string
-var commonPasswordList []string
-var disposableDomains []string
-var disposableWildcards []string
-
 func init() {
-	iso3166countryCodes = mustFormatCSVBytes(MustAsset("data/iso3166-country-codes.csv"))
-	commonPasswordList = mustFormatCSVBytes(MustAsset("data/common-password-list-minlength-8-characters.csv"))
-	disposableDomains = mustFormatCSVBytes(MustAsset("data/disposable-email-domains.csv"))
-	disposableWildcards = mustFormatCSVBytes(MustAsset("data/disposable-email-domains-wildcards.csv"))
-	iso4217currencyCodes = mustFormatCSVBytes(MustAsset("data/iso4217-currency-codes.csv"))
+	DisposableDomains.onChange = loadDisposableList
+	DisposableWildcards.onChange = loadDisposableList
+	FreeEmailProviders.onChange = loadFreeEmailList
+
+	// the bundled lists, see Dataset
+	versions := mustReadVersions(mustAsset("data/versions.csv"))
+	for _, d := range bundledDatasets {
+		d.Replace(mustFormatCSVBytes(mustAsset("data/" + d.Name() + ".csv"))...)
+		if v, ok := versions[d.Name()]; ok {
+			d.version = v
+		}
+	}
 }
 
 /*
@@ -28,6 +30,30 @@ type Rule interface {
 	Validate([]string, map[string]string) (error, []interface{})
 }
 
+/*
+	Rules that can rewrite a valid entry into a standard form also implement Normalizer. ("1.234,56" -> "1234.56" for a German locale)
+	FormValidator.Validate stores the normalized entry in the form if all the rules for the field pass, the first rule in the chain that implements Normalizer is used.
+*/
+type Normalizer interface {
+	Normalize(string) string
+}
+
+/*
+	Rules that can be set up wrong (a regular expression that does not compile) implement Checker
+	New() returns the error, so it is found when the application starts instead of when a form is submitted.
+*/
+type Checker interface {
+	Check() error
+}
+
+/*
+	A GroupRule validates several form fields together, (start and end dates, ...)
+	It returns the errors by field name, so each error is attached to the right field. See FormValidator.AddGroup
+*/
+type GroupRule interface {
+	ValidateGroup(url.Values, map[string]string) map[string][]FormError
+}
+
 // Setup all the form validation rules
 // Note: case sensitive, if form field is "email" and map field is "Email" the validator will fail!
 func RuleChain(rules ...Rule) []Rule {
@@ -43,6 +69,8 @@ type FormValidator struct {
 	rules                map[string][]Rule
 	errorMessages        map[string]string
 	blankFormDataOnError bool
+	locale               *Locale
+	groups               []GroupRule
 }
 
 // errors
@@ -54,50 +82,125 @@ func New(rules map[string][]Rule) (error, *FormValidator) {
 		return ErrNilArguments, nil
 	}
 
+	// in order of the field names, the same error every time
+	var fieldNames []string
+	for fieldName := range rules {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		for _, r := range rules[fieldName] {
+			if c, ok := r.(Checker); ok {
+				if err := c.Check(); err != nil {
+					return fmt.Errorf("%s: %w", fieldName, err), nil
+				}
+			}
+		}
+	}
+
 	// default error messages for invalid form entries
 	// extra error messages (those that are not used in field validation functions) are here for convenience, grouped for translation.
 	var errors = map[string]string{
-		"account":          "The e-mail or password you entered is incorrect.",
-		"alpha_num":        "This field may only contain letters and numbers.",
-		"boolean":          "This field must be true or false.",
-		"captcha":          "The characters you entered did not match the word verification. Please retry.",
-		"city":             "We could not find that city. Please check your spelling.",
-		"country_code":     "Please select a valid country.",
-		"credit_card":      "Please enter a valid credit card number.",
-		"csrf":             "There was an error submitting the form. Please retry.",
-		"currency_code":    "Please enter a valid currency code.",
-		"date":             "This field must be in a date format (DD-MM-YYYY) [Ex: 31-12-1990]",
-		"date_time":        "This field must be in a date-time format (DD-MM-YYYY HH:MM:SS) [Ex: 31-12-1990 14:23:56]",
-		"delimiter_min":    "Entries must be at least %d characters long.",
-		"delimiter_max":    "Entries cannot be more than %d characters long.",
-		"duplicate":        "This field cannot contain duplicate entries.",
-		"email":            "Please enter a valid e-mail address.",
-		"email_taken":      "That e-mail address is already in use.",
-		"float":            "This field must be a floating point number. (Example: -10.50)",
-		"float_range":      "This field must be between %f - %f.",
-		"in_list":          "Please make a selection.",
-		"int_range":        "This field must be between %d - %d.",
-		"isbn":             "Please enter a valid ISBN.",
-		"json":             "This field must contain valid JSON (Javascript object notation).",
-		"latitude":         "Latitude must be between -90.0 degrees and 90.0 degrees.",
-		"longitude":        "Longitude must be between -180.0 degrees and 180.0 degrees.",
-		"multiple_entries": "This field may only contain one entry.",
-		"not_in_list":      "This field contains an invalid entry.",
-		"numeric":          "This field must contain enter only numbers.",
-		"required":         "This field is required.",
-		"slug":             "This field must contain at least one letter or number.",
-		"string_matches":   "Fields did not match.",
-		"string_max":       "This field cannot be more than %d characters long.",
-		"string_min":       "This field must be at least %d characters long.",
-		"time":             "This field must be in a time format (HH:MM:SS) [Ex: 14:23:56]",
-		"unselected_field": "Please select this field.",
-		"utf8_letter_num":  "This field may only contain letters and numbers (Character set: UTF8).",
-		"uuid":             "Please enter a valid UUID.",
-		"weak_password":    "Please use a stronger password.",
-		"web_request_uri":  "Please enter a valid Web URI.",
+		"account":           "The e-mail or password you entered is incorrect.",
+		"alpha_num":         "This field may only contain letters and numbers.",
+		"bic":               "Please enter a valid BIC/SWIFT code.",
+		"bic_iban_mismatch": "The BIC does not match the country of the IBAN.",
+		"boolean":           "This field must be true or false.",
+		"breach_unchecked":  "The password could not be checked. Please try again later.",
+		"captcha":           "The characters you entered did not match the word verification. Please retry.",
+		"card_brand":        "We do not accept this card type.",
+		"card_expired":      "This card has expired.",
+		"card_expiry":       "Please enter a valid expiration date.",
+		"check_digit":       "This number is not valid, please check it for typos.",
+		"cidr":              "Please enter a valid network in CIDR notation. [Ex: 192.168.0.0/16]",
+		"cidr_prefix":       "The network prefix must be between /%d and /%d.",
+		"city":              "We could not find that city. Please check your spelling.",
+		"cnpj":              "Please enter a valid CNPJ.",
+		"country_code":      "Please select a valid country.",
+		"cpf":               "Please enter a valid CPF.",
+		"credit_card":       "Please enter a valid credit card number.",
+		"csrf":              "There was an error submitting the form. Please retry.",
+		"currency_code":     "Please enter a valid currency code.",
+		"cvv":               "Please enter a valid security code.",
+		"date":              "This field must be in a date format (DD-MM-YYYY) [Ex: 31-12-1990]",
+		"date_future":       "This date cannot be in the future.",
+		"date_layout":       "This field must be in a date format (%s) [Ex: %s]",
+		"date_max":          "This date cannot be after %s.",
+		"date_min":          "This date cannot be before %s.",
+		"date_past":         "This date cannot be in the past.",
+		"date_range_max":    "The date range cannot be longer than %d days.",
+		"date_range_min":    "The date range must be at least %d days long.",
+		"date_range_order":  "The end date cannot be before the start date.",
+		"date_time":         "This field must be in a date-time format (DD-MM-YYYY HH:MM:SS) [Ex: 31-12-1990 14:23:56]",
+		"date_time_layout":  "This field must be in a date-time format (%s) [Ex: %s]",
+		"delimiter_min":     "Entries must be at least %d characters long.",
+		"delimiter_max":     "Entries cannot be more than %d characters long.",
+		"duplicate":         "This field cannot contain duplicate entries.",
+		"email":             "Please enter a valid e-mail address.",
+		"email_deliverable": "The domain %s does not accept e-mail.",
+		"email_disposable":  "E-mail addresses from %s are not accepted.",
+		"email_domain":      "E-mail addresses from %s are not allowed.",
+		"email_free":        "Please use your work e-mail address, not one from %s.",
+		"email_length":      "This e-mail address is too long.",
+		"email_role":        "Please use a personal e-mail address, not a shared one like %s@.",
+		"email_taken":       "That e-mail address is already in use.",
+		"email_typo":        "Did you mean %s?",
+		"float":             "This field must be a floating point number. (Example: -10.50)",
+		"float_range":       "This field must be between %f - %f.",
+		"gtin":              "Please enter a valid barcode number (GTIN/EAN).",
+		"host_port":         "Please enter a host and port. [Ex: example.com:443]",
+		"hostname":          "Please enter a valid host name.",
+		"iban":              "Please enter a valid IBAN.",
+		"in_list":           "Please make a selection.",
+		"int_range":         "This field must be between %d - %d.",
+		"ip":                "Please enter a valid IP address.",
+		"ip_public":         "Please enter a public IP address.",
+		"isbn":              "Please enter a valid ISBN.",
+		"ismn":              "Please enter a valid ISMN.",
+		"issn":              "Please enter a valid ISSN.",
+		"json":              "This field must contain valid JSON (Javascript object notation).",
+		"ksuid":             "Please enter a valid KSUID.",
+		"latitude":          "Latitude must be between -90.0 degrees and 90.0 degrees.",
+		"longitude":         "Longitude must be between -180.0 degrees and 180.0 degrees.",
+		"mac":               "Please enter a valid MAC address.",
+		"max_age":           "You cannot be more than %d years old.",
+		"min_age":           "You must be at least %d years old.",
+		"multiple_entries":  "This field may only contain one entry.",
+		"not_in_list":       "This field contains an invalid entry.",
+		"numeric":           "This field must contain enter only numbers.",
+		"password_breached": "This password has appeared %d times in data breaches. Please choose another one.",
+		"phone":             "Please enter a valid phone number.",
+		"phone_type":        "This type of phone number is not accepted.",
+		"port":              "Please enter a port number between %d and %d.",
+		"postal_code":       "Please enter a valid postal code.",
+		"required":          "This field is required.",
+		"slug":              "This field must contain at least one letter or number.",
+		"ssn":               "Please enter a valid Social Security number.",
+		"string_matches":    "Fields did not match.",
+		"string_max":        "This field cannot be more than %d characters long.",
+		"string_min":        "This field must be at least %d characters long.",
+		"tax_id":            "Please enter a valid tax identification number.",
+		"time":              "This field must be in a time format (HH:MM:SS) [Ex: 14:23:56]",
+		"time_between":      "This time must be between %s and %s.",
+		"time_layout":       "This field must be in a time format (%s) [Ex: %s]",
+		"ulid":              "Please enter a valid ULID.",
+		"unselected_field":  "Please select this field.",
+		"upc":               "Please enter a valid UPC.",
+		"url":               "Please enter a valid web address.",
+		"url_host":          "This web address is not allowed.",
+		"url_length":        "The web address cannot be more than %d characters long.",
+		"url_private":       "This web address points to a private network.",
+		"url_resolve":       "We could not find the server of this web address.",
+		"utf8_letter_num":   "This field may only contain letters and numbers (Character set: UTF8).",
+		"uuid":              "Please enter a valid UUID.",
+		"vat_number":        "Please enter a valid VAT number.",
+		"weak_password":     "Please use a stronger password.",
+		"web_request_uri":   "Please enter a valid Web URI.",
+		"weekday":           "This day of the week is not available.",
 	}
 
-	return nil, &FormValidator{rules, errors, true}
+	return nil, &FormValidator{rules, errors, true, LocaleEnUS, nil}
 }
 
 // custom error messages option
@@ -110,6 +213,26 @@ func (f *FormValidator) SetErrors(msgs map[string]string) error {
 	return nil
 }
 
+// numbers and dates in error messages are written for this locale (default: LocaleEnUS)
+func (f *FormValidator) SetLocale(l *Locale) error {
+	if l == nil {
+		return ErrNilArguments
+	}
+
+	f.locale = l
+	return nil
+}
+
+// rules that validate several fields together, they run after the rules for each field
+func (f *FormValidator) AddGroup(g GroupRule) error {
+	if g == nil {
+		return ErrNilArguments
+	}
+
+	f.groups = append(f.groups, g)
+	return nil
+}
+
 // option to not blank the form field if there is an error after validating
 func (f *FormValidator) SetBlankOnError(b bool) {
 	f.blankFormDataOnError = b
@@ -129,10 +252,22 @@ func (f *FormValidator) GetErrorMessage(key string) string {
 
 	Loop through the rules and validate each entry
 	returns (bool, if the form is valid, map for error messages)
+	Warnings (FormError.Warning) are returned with the errors, but the form can still be valid
 */
 func (f *FormValidator) Validate(form url.Values) (bool, map[string][]FormError) {
 	allErrors := make(map[string][]FormError)
 
+	// group rules see the form as it was submitted, before any field is blanked or normalized
+	groupErrors := make(map[string][]FormError)
+	for _, g := range f.groups {
+		for fieldName, errors := range g.ValidateGroup(form, f.errorMessages) {
+			for _, e := range errors {
+				e.Locale = f.locale
+				groupErrors[fieldName] = appendError(groupErrors[fieldName], &e)
+			}
+		}
+	}
+
 	for fieldName, ruleSlice := range f.rules { // loop through map fields, Note: case sensitive, if form field is "email" and map field is "Email" the validator will fail!
 
 		val, _ := form[fieldName] // this will be an empty slice if 'fieldName' does not exist in the map
@@ -140,20 +275,43 @@ func (f *FormValidator) Validate(form url.Values) (bool, map[string][]FormError)
 		var errors []FormError
 		for _, r := range ruleSlice { // loop through rule slice
 			if err, data := r.Validate(val, f.errorMessages); err != nil {
-				errors = appendError(errors, &FormError{err.Error(), data}) // format errors for translation (string separate from extra data)
+				errors = appendError(errors, &FormError{err.Error(), data, isWarning(err), f.locale}) // format errors for translation (string separate from extra data)
 			}
 		}
+		errors = append(errors, groupErrors[fieldName]...)
+		delete(groupErrors, fieldName)
 		allErrors[fieldName] = errors // set the errors for the form entry
 
-		if len(errors) > 0 && f.blankFormDataOnError { // blank the field in original form map if there is an error
+		if !hasErrors(errors) && len(val) == 1 && len(val[0]) > 0 { // store the normalized entry, the first rule that can normalize it is used
+			for _, r := range ruleSlice {
+				if n, ok := r.(Normalizer); ok {
+					form.Set(fieldName, n.Normalize(val[0]))
+					break
+				}
+			}
+		}
+
+		if hasErrors(errors) && f.blankFormDataOnError { // blank the field in original form map if there is an error, warnings do not count
 			form.Set(fieldName, "")
 		}
 
 	}
 
-	if len(allErrors) == 0 {
-		return true, allErrors
+	// fields that only have group rules
+	for fieldName, errors := range groupErrors {
+		allErrors[fieldName] = errors
+
+		if hasErrors(errors) && f.blankFormDataOnError {
+			form.Set(fieldName, "")
+		}
+	}
+
+	// every field has an entry, even without errors, warnings do not make the form invalid
+	for _, errors := range allErrors {
+		if hasErrors(errors) {
+			return false, allErrors
+		}
 	}
 
-	return false, allErrors
+	return true, allErrors
 }
@@ -2,6 +2,7 @@ package formvalidator
 
 import (
 	"net/url"
+	"strings"
 	"testing"
 )
 
@@ -92,7 +93,7 @@ func TestValidate(t *testing.T) {
 	isValid, errors := validator.Validate(form)
 
 	if isValid != false {
-		t.Errorf("TestValidate(): validation should have failed!: %b", isValid)
+		t.Errorf("TestValidate(): validation should have failed!: %t", isValid)
 	}
 
 	if errors["FirstName"][0].Error() != "What do you know Joe?" {
@@ -111,3 +112,121 @@ func TestValidate(t *testing.T) {
 		t.Errorf("TestValidate(): LastName should not be blank in the form! [%s]", form.Get("LastName"))
 	}
 }
+
+func TestValidateNormalize(t *testing.T) {
+	form := url.Values{}
+	form.Set("Amount", "1.234,56")
+	form.Set("Quantity", "12.500")
+
+	rules := map[string][]Rule{
+		"Amount":   RuleChain(Required(), IsFloat64Locale(LocaleDeDE), FloatRangeLocale(0.01, 5000, LocaleDeDE)),
+		"Quantity": RuleChain(IntRangeLocale(1, 10, LocaleDeDE)),
+	}
+
+	_, validator := New(rules)
+	validator.SetLocale(LocaleDeDE)
+	_, errors := validator.Validate(form)
+
+	if len(errors["Amount"]) > 0 || form.Get("Amount") != "1234.56" {
+		t.Errorf("TestValidateNormalize(): Amount should be normalized! [%s] %v", form.Get("Amount"), errors["Amount"])
+	}
+
+	if len(errors["Quantity"]) != 1 || form.Get("Quantity") != "" {
+		t.Errorf("TestValidateNormalize(): Quantity should fail and be blank! [%s]", form.Get("Quantity"))
+	}
+
+	validator.SetErrors(map[string]string{"int_range": "Zwischen %d und %d"})
+	form.Set("Quantity", "12.500")
+	_, errors = validator.Validate(form)
+	if len(errors["Quantity"]) != 1 || errors["Quantity"][0].Error() != "Zwischen 1 und 10" {
+		t.Errorf("TestValidateNormalize(): Unexpected error message! %v", errors["Quantity"])
+	}
+}
+
+func TestValidateGroup(t *testing.T) {
+	form := url.Values{}
+	form.Set("Start", "2017-03-10")
+	form.Set("End", "2017-03-01")
+	form.Set("Note", "Window seat")
+
+	rules := map[string][]Rule{
+		"Start": RuleChain(Required(), IsDateLayout(LayoutHTMLDate)),
+		"Note":  RuleChain(StrLen(1, 50)),
+	}
+
+	_, validator := New(rules)
+	if err := validator.AddGroup(DateRange("Start", "End", 0, 90, &DateConfig{Layouts: []string{LayoutHTMLDate}})); err != nil {
+		t.Errorf("TestValidateGroup(): %s", err.Error())
+	}
+
+	isValid, errors := validator.Validate(form)
+
+	if isValid != false {
+		t.Errorf("TestValidateGroup(): validation should have failed!: %t", isValid)
+	}
+
+	if len(errors["Start"]) != 0 || len(errors["Note"]) != 0 {
+		t.Errorf("TestValidateGroup(): Start and Note should not have errors! %v", errors)
+	}
+
+	if len(errors["End"]) != 1 || errors["End"][0].Error() != validator.GetErrorMessage("date_range_order") {
+		t.Errorf("TestValidateGroup(): End should have one error! %v", errors["End"])
+	}
+
+	if form.Get("End") != "" || form.Get("Start") != "2017-03-10" {
+		t.Errorf("TestValidateGroup(): Only End should be blank in the form! [%s, %s]", form.Get("Start"), form.Get("End"))
+	}
+
+	form.Set("End", "2017-03-20")
+	if isValid, errors = validator.Validate(form); isValid != true {
+		t.Errorf("TestValidateGroup(): validation should have passed! %v", errors)
+	}
+}
+
+func TestValidateWarning(t *testing.T) {
+	form := url.Values{}
+	form.Set("Email", "Bob@GMIAL.com")
+
+	rules := map[string][]Rule{
+		"Email": RuleChain(Required(), EmailAddress(EmailOptions{}), EmailTypo(nil, nil)),
+	}
+
+	_, validator := New(rules)
+	isValid, errors := validator.Validate(form)
+
+	if isValid != true {
+		t.Errorf("TestValidateWarning(): validation should have passed, warnings do not count! %v", errors)
+	}
+
+	if len(errors["Email"]) != 1 || !errors["Email"][0].Warning || errors["Email"][0].Error() != "Did you mean Bob@gmail.com?" {
+		t.Errorf("TestValidateWarning(): Email should have one warning! %v", errors["Email"])
+	}
+
+	if form.Get("Email") != "Bob@gmial.com" {
+		t.Errorf("TestValidateWarning(): Email should be kept and normalized! [%s]", form.Get("Email"))
+	}
+
+	form.Set("Email", "Bob@GMIAL")
+	if isValid, errors = validator.Validate(form); isValid != false || len(errors["Email"]) != 1 || errors["Email"][0].Warning || form.Get("Email") != "" {
+		t.Errorf("TestValidateWarning(): validation should have failed! [%s] %v", form.Get("Email"), errors["Email"])
+	}
+}
+
+func TestNewChecksRules(t *testing.T) {
+	rules := map[string][]Rule{
+		"Email":  RuleChain(Required(), Email(true)),
+		"Order":  RuleChain(Regex(`^[A-Z`, "order_number")),
+		"Order2": RuleChain(Regex(`(`, "order_number")),
+	}
+
+	err, validator := New(rules)
+	if err == nil || validator != nil || !strings.HasPrefix(err.Error(), "Order: Regex(") {
+		t.Errorf("TestNewChecksRules(): New() should return the bad pattern! [%v]", err)
+	}
+
+	rules["Order"] = RuleChain(Regex(`^[A-Z]{3}$`, "order_number"))
+	delete(rules, "Order2")
+	if err, _ := New(rules); err != nil {
+		t.Errorf("TestNewChecksRules(): %s", err.Error())
+	}
+}